@@ -0,0 +1,98 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Command tss-migrate upgrades the JSON-encoded ECDSA save data of a party, as written by older versions of tss-lib.
+//
+//	tss-migrate -threshold 2 -in party.json -out party-migrated.json
+//
+// It reports the version of the save data and, when the pre-params it lacks can be recovered from its secrets,
+// writes the upgraded save data to -out. It exits with status 2 when the save data cannot be upgraded locally; the
+// party must then take part in a refresh with new pre-params, run with migration.NewRefreshParty.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/migration"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	exitError           = 1
+	exitRefreshRequired = 2
+)
+
+func main() {
+	var (
+		in        = flag.String("in", "", "the JSON-encoded save data to migrate")
+		out       = flag.String("out", "", "where to write the migrated save data; the save data is only checked when empty")
+		curve     = flag.String("curve", string(tss.Secp256k1), "the curve of the key")
+		threshold = flag.Int("threshold", 0, "the threshold of the key")
+	)
+	flag.Parse()
+	if *in == "" || *threshold < 1 {
+		flag.Usage()
+		os.Exit(exitError)
+	}
+	if err := run(*in, *out, tss.CurveName(*curve), *threshold); err != nil {
+		fmt.Fprintln(os.Stderr, "tss-migrate:", err)
+		if errors.Is(err, migration.ErrRefreshRequired) {
+			os.Exit(exitRefreshRequired)
+		}
+		os.Exit(exitError)
+	}
+}
+
+func run(in, out string, curve tss.CurveName, threshold int) error {
+	ec, ok := tss.GetCurveByName(curve)
+	if !ok {
+		return fmt.Errorf("unknown curve %q", curve)
+	}
+	bz, err := os.ReadFile(in)
+	if err != nil {
+		return err
+	}
+	var data keygen.LocalPartySaveData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return err
+	}
+	for _, Xj := range data.BigXj {
+		if Xj != nil {
+			Xj.SetCurve(ec)
+		}
+	}
+	if data.ECDSAPub != nil {
+		data.ECDSAPub.SetCurve(ec)
+	}
+
+	version := migration.DetectVersion(data)
+	fmt.Println("version:", version)
+	upgraded, err := migration.Upgrade(data, ec, threshold)
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		if version == migration.VersionCurrent {
+			fmt.Println("the save data is valid")
+		} else {
+			fmt.Println("the save data can be upgraded locally")
+		}
+		return nil
+	}
+	if bz, err = json.Marshal(upgraded); err != nil {
+		return err
+	}
+	if err := os.WriteFile(out, bz, 0o600); err != nil {
+		return err
+	}
+	fmt.Println("wrote the upgraded save data to", out)
+	return nil
+}
@@ -7,7 +7,114 @@
 package common
 
 import (
+	"context"
+	"strconv"
+	"time"
+
 	"github.com/ipfs/go-log"
 )
 
+// Logger is the global logger of the library. It backs the default StructuredLogger of every party, and is used
+// directly only where no party is known.
 var Logger = log.Logger("tss-lib")
+
+type (
+	// StructuredLogger writes a constant message with fields attached. A party logs through the one set with
+	// Parameters.SetLogger, with its session, task, round and party as fields.
+	//
+	// Fields can only be built with the constructors below, which take strings, numbers, durations and errors. There
+	// is no field for a *big.Int, a point or a byte slice, so the secret values of a party cannot be logged without
+	// first being converted on purpose.
+	StructuredLogger interface {
+		Debug(msg string, fields ...Field)
+		Info(msg string, fields ...Field)
+		Warn(msg string, fields ...Field)
+		Error(msg string, fields ...Field)
+		// With returns a logger that attaches `fields` to every line
+		With(fields ...Field) StructuredLogger
+	}
+
+	Field struct {
+		Key   string
+		Value string
+	}
+
+	goLogger struct {
+		keysAndValues []interface{}
+	}
+
+	loggerKey struct{}
+)
+
+func StringField(key, value string) Field {
+	return Field{Key: key, Value: value}
+}
+
+func IntField(key string, value int) Field {
+	return Field{Key: key, Value: strconv.Itoa(value)}
+}
+
+func DurationField(key string, value time.Duration) Field {
+	return Field{Key: key, Value: value.String()}
+}
+
+func ErrorField(err error) Field {
+	if err == nil {
+		return Field{Key: "error"}
+	}
+	return Field{Key: "error", Value: err.Error()}
+}
+
+// DefaultLogger returns a StructuredLogger writing to the global Logger, whose level is set with
+// log.SetLogLevel("tss-lib", level)
+func DefaultLogger() StructuredLogger {
+	return goLogger{}
+}
+
+func (l goLogger) Debug(msg string, fields ...Field) {
+	Logger.Debugw(msg, l.with(fields).keysAndValues...)
+}
+
+func (l goLogger) Info(msg string, fields ...Field) {
+	Logger.Infow(msg, l.with(fields).keysAndValues...)
+}
+
+func (l goLogger) Warn(msg string, fields ...Field) {
+	Logger.Warnw(msg, l.with(fields).keysAndValues...)
+}
+
+func (l goLogger) Error(msg string, fields ...Field) {
+	Logger.Errorw(msg, l.with(fields).keysAndValues...)
+}
+
+func (l goLogger) With(fields ...Field) StructuredLogger {
+	return l.with(fields)
+}
+
+func (l goLogger) with(fields []Field) goLogger {
+	if len(fields) == 0 {
+		return l
+	}
+	kv := make([]interface{}, len(l.keysAndValues), len(l.keysAndValues)+2*len(fields))
+	copy(kv, l.keysAndValues)
+	for _, f := range fields {
+		kv = append(kv, f.Key, f.Value)
+	}
+	return goLogger{keysAndValues: kv}
+}
+
+// ----- //
+
+// ContextWithLogger returns a context carrying `logger`, for the helpers that are given a context but no
+// parameters, e.g. the generation of pre-params
+func ContextWithLogger(ctx context.Context, logger StructuredLogger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// LoggerFromContext returns the logger of `ctx`, or the default logger
+func LoggerFromContext(ctx context.Context) StructuredLogger {
+	if logger, ok := ctx.Value(loggerKey{}).(StructuredLogger); ok {
+		return logger
+	}
+	return DefaultLogger()
+}
@@ -7,11 +7,14 @@
 package common
 
 import (
-	"crypto/rand"
+	cryptorand "crypto/rand"
+	"crypto/sha256"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/chacha20"
 )
 
 const (
@@ -20,6 +23,12 @@ const (
 
 // MustGetRandomInt panics if it is unable to gather entropy from `rand.Reader` or when `bits` is <= 0
 func MustGetRandomInt(bits int) *big.Int {
+	return MustGetRandomIntWithRand(cryptorand.Reader, bits)
+}
+
+// MustGetRandomIntWithRand is MustGetRandomInt with its randomness drawn from `rand`, and panics if it is unable to
+// gather entropy from it
+func MustGetRandomIntWithRand(rand io.Reader, bits int) *big.Int {
 	if bits <= 0 || mustGetRandomIntMaxBits < bits {
 		panic(fmt.Errorf("MustGetRandomInt: bits should be positive, non-zero and less than %d", mustGetRandomIntMaxBits))
 	}
@@ -28,7 +37,7 @@ func MustGetRandomInt(bits int) *big.Int {
 	max = max.Exp(two, big.NewInt(int64(bits)), nil).Sub(max, one)
 
 	// Generate cryptographically strong pseudo-random int between 0 - max
-	n, err := rand.Int(rand.Reader, max)
+	n, err := cryptorand.Int(rand, max)
 	if err != nil {
 		panic(errors.Wrap(err, "rand.Int failure in MustGetRandomInt!"))
 	}
@@ -36,12 +45,17 @@ func MustGetRandomInt(bits int) *big.Int {
 }
 
 func GetRandomPositiveInt(lessThan *big.Int) *big.Int {
+	return GetRandomPositiveIntWithRand(cryptorand.Reader, lessThan)
+}
+
+// GetRandomPositiveIntWithRand is GetRandomPositiveInt with its randomness drawn from `rand`
+func GetRandomPositiveIntWithRand(rand io.Reader, lessThan *big.Int) *big.Int {
 	if lessThan == nil || zero.Cmp(lessThan) != -1 {
 		return nil
 	}
 	var try *big.Int
 	for {
-		try = MustGetRandomInt(lessThan.BitLen())
+		try = MustGetRandomIntWithRand(rand, lessThan.BitLen())
 		if try.Cmp(lessThan) < 0 && try.Cmp(zero) >= 0 {
 			break
 		}
@@ -50,32 +64,51 @@ func GetRandomPositiveInt(lessThan *big.Int) *big.Int {
 }
 
 func GetRandomPrimeInt(bits int) *big.Int {
+	return GetRandomPrimeIntWithRand(cryptorand.Reader, bits)
+}
+
+// GetRandomPrimeIntWithRand is GetRandomPrimeInt with its randomness drawn from `rand`
+func GetRandomPrimeIntWithRand(rand io.Reader, bits int) *big.Int {
 	if bits <= 0 {
 		return nil
 	}
-	try, err := rand.Prime(rand.Reader, bits)
+	if rand != cryptorand.Reader {
+		// crypto/rand.Prime may read a varying number of bytes, so other readers are searched here to always give the
+		// same prime for the same bytes
+		return searchPrimeInt(rand, bits)
+	}
+	try, err := cryptorand.Prime(rand, bits)
 	if err != nil ||
 		try.Cmp(zero) == 0 {
 		// fallback to older method
-		for {
-			try = MustGetRandomInt(bits)
-			if probablyPrime(try) {
-				break
-			}
-		}
+		try = searchPrimeInt(rand, bits)
 	}
 	return try
 }
 
+func searchPrimeInt(rand io.Reader, bits int) *big.Int {
+	for {
+		try := MustGetRandomIntWithRand(rand, bits)
+		if probablyPrime(try) {
+			return try
+		}
+	}
+}
+
 // Generate a random element in the group of all the elements in Z/nZ that
 // has a multiplicative inverse.
 func GetRandomPositiveRelativelyPrimeInt(n *big.Int) *big.Int {
+	return GetRandomPositiveRelativelyPrimeIntWithRand(cryptorand.Reader, n)
+}
+
+// GetRandomPositiveRelativelyPrimeIntWithRand is GetRandomPositiveRelativelyPrimeInt with its randomness drawn from `rand`
+func GetRandomPositiveRelativelyPrimeIntWithRand(rand io.Reader, n *big.Int) *big.Int {
 	if n == nil || zero.Cmp(n) != -1 {
 		return nil
 	}
 	var try *big.Int
 	for {
-		try = MustGetRandomInt(n.BitLen())
+		try = MustGetRandomIntWithRand(rand, n.BitLen())
 		if IsNumberInMultiplicativeGroup(n, try) {
 			break
 		}
@@ -97,15 +130,25 @@ func IsNumberInMultiplicativeGroup(n, v *big.Int) bool {
 //
 // https://github.com/didiercrunch/paillier/blob/d03e8850a8e4c53d04e8016a2ce8762af3278b71/utils.go#L39
 func GetRandomGeneratorOfTheQuadraticResidue(n *big.Int) *big.Int {
-	f := GetRandomPositiveRelativelyPrimeInt(n)
+	return GetRandomGeneratorOfTheQuadraticResidueWithRand(cryptorand.Reader, n)
+}
+
+// GetRandomGeneratorOfTheQuadraticResidueWithRand is GetRandomGeneratorOfTheQuadraticResidue with its randomness drawn from `rand`
+func GetRandomGeneratorOfTheQuadraticResidueWithRand(rand io.Reader, n *big.Int) *big.Int {
+	f := GetRandomPositiveRelativelyPrimeIntWithRand(rand, n)
 	fSq := new(big.Int).Mul(f, f)
 	return fSq.Mod(fSq, n)
 }
 
 // GetRandomQuadraticNonResidue returns a quadratic non residue of odd n.
 func GetRandomQuadraticNonResidue(n *big.Int) *big.Int {
+	return GetRandomQuadraticNonResidueWithRand(cryptorand.Reader, n)
+}
+
+// GetRandomQuadraticNonResidueWithRand is GetRandomQuadraticNonResidue with its randomness drawn from `rand`
+func GetRandomQuadraticNonResidueWithRand(rand io.Reader, n *big.Int) *big.Int {
 	for {
-		w := GetRandomPositiveInt(n)
+		w := GetRandomPositiveIntWithRand(rand, n)
 		if big.Jacobi(w, n) == -1 {
 			return w
 		}
@@ -114,16 +157,63 @@ func GetRandomQuadraticNonResidue(n *big.Int) *big.Int {
 
 // GetRandomBytes returns random bytes of length.
 func GetRandomBytes(length int) ([]byte, error) {
+	return GetRandomBytesWithRand(cryptorand.Reader, length)
+}
+
+// GetRandomBytesWithRand is GetRandomBytes with its randomness drawn from `rand`
+func GetRandomBytesWithRand(rand io.Reader, length int) ([]byte, error) {
 	// Per [BIP32], the seed must be in range [MinSeedBytes, MaxSeedBytes].
 	if length <= 0 {
 		return nil, errors.New("invalid length")
 	}
 
 	buf := make([]byte, length)
-	_, err := rand.Read(buf)
+	_, err := io.ReadFull(rand, buf)
 	if err != nil {
 		return nil, err
 	}
 
 	return buf, nil
 }
+
+// NewDeterministicReader returns a reader of an endless stream of bytes derived from `seed`.
+// It is meant for tests and test vectors only; key material drawn from it is as secret as the seed.
+func NewDeterministicReader(seed []byte) io.Reader {
+	key := sha256.Sum256(seed)
+	nonce := make([]byte, chacha20.NonceSize)
+	cipher, err := chacha20.NewUnauthenticatedCipher(key[:], nonce)
+	if err != nil {
+		panic(errors.Wrap(err, "chacha20 failure in NewDeterministicReader!"))
+	}
+	return &deterministicReader{cipher: cipher}
+}
+
+type deterministicReader struct {
+	cipher *chacha20.Cipher
+}
+
+func (r *deterministicReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	r.cipher.XORKeyStream(p, p)
+	return len(p), nil
+}
+
+// SplitRandom returns `n` readers to be used by concurrent goroutines. Each is seeded in order from `rand`, so the
+// bytes every goroutine draws do not depend on scheduling. The system source is safe to share and is returned as is.
+func SplitRandom(rand io.Reader, n int) ([]io.Reader, error) {
+	readers := make([]io.Reader, n)
+	for i := range readers {
+		if rand == cryptorand.Reader {
+			readers[i] = rand
+			continue
+		}
+		seed, err := GetRandomBytesWithRand(rand, sha256.Size)
+		if err != nil {
+			return nil, err
+		}
+		readers[i] = NewDeterministicReader(seed)
+	}
+	return readers, nil
+}
@@ -7,6 +7,7 @@
 package common_test
 
 import (
+	"crypto/rand"
 	"math/big"
 	"testing"
 
@@ -45,3 +46,37 @@ func TestGetRandomPrimeInt(t *testing.T) {
 	assert.NotZero(t, prime, "rand prime should not be zero")
 	assert.True(t, prime.ProbablyPrime(50), "rand prime should be prime")
 }
+
+func TestDeterministicReader(t *testing.T) {
+	rnd1 := common.MustGetRandomIntWithRand(common.NewDeterministicReader([]byte("seed")), randomIntBitLen)
+	rnd2 := common.MustGetRandomIntWithRand(common.NewDeterministicReader([]byte("seed")), randomIntBitLen)
+	rnd3 := common.MustGetRandomIntWithRand(common.NewDeterministicReader([]byte("another seed")), randomIntBitLen)
+	assert.Equal(t, 0, rnd1.Cmp(rnd2), "the same seed should give the same int")
+	assert.NotEqual(t, 0, rnd1.Cmp(rnd3), "another seed should give another int")
+}
+
+func TestGetRandomPrimeIntWithDeterministicReader(t *testing.T) {
+	prime1 := common.GetRandomPrimeIntWithRand(common.NewDeterministicReader([]byte("seed")), 256)
+	prime2 := common.GetRandomPrimeIntWithRand(common.NewDeterministicReader([]byte("seed")), 256)
+	assert.True(t, prime1.ProbablyPrime(50), "rand prime should be prime")
+	assert.Equal(t, 0, prime1.Cmp(prime2), "the same seed should give the same prime")
+}
+
+func TestSplitRandom(t *testing.T) {
+	rands1, err := common.SplitRandom(common.NewDeterministicReader([]byte("seed")), 2)
+	assert.NoError(t, err)
+	rands2, err := common.SplitRandom(common.NewDeterministicReader([]byte("seed")), 2)
+	assert.NoError(t, err)
+	// the readers may be drawn from in any order
+	b2 := common.MustGetRandomIntWithRand(rands2[1], randomIntBitLen)
+	a2 := common.MustGetRandomIntWithRand(rands2[0], randomIntBitLen)
+	a1 := common.MustGetRandomIntWithRand(rands1[0], randomIntBitLen)
+	b1 := common.MustGetRandomIntWithRand(rands1[1], randomIntBitLen)
+	assert.Equal(t, 0, a1.Cmp(a2))
+	assert.Equal(t, 0, b1.Cmp(b2))
+	assert.NotEqual(t, 0, a1.Cmp(b1))
+
+	rands, err := common.SplitRandom(rand.Reader, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, rand.Reader, rands[0])
+}
@@ -126,6 +126,12 @@ var ErrGeneratorCancelled = fmt.Errorf("generator work cancelled")
 // generated safe prime, the two most significant bits are always set to `1`
 // - we don't want the generated number to be too small.
 func GetRandomSafePrimesConcurrent(ctx context.Context, bitLen, numPrimes int, concurrency int) ([]*GermainSafePrime, error) {
+	return GetRandomSafePrimesConcurrentWithRand(ctx, rand.Reader, bitLen, numPrimes, concurrency)
+}
+
+// GetRandomSafePrimesConcurrentWithRand is GetRandomSafePrimesConcurrent with its randomness drawn from `rand`. The
+// search routines share `rand`, which must be safe for concurrent use.
+func GetRandomSafePrimesConcurrentWithRand(ctx context.Context, rand io.Reader, bitLen, numPrimes int, concurrency int) ([]*GermainSafePrime, error) {
 	if bitLen < 6 {
 		return nil, errors.New("safe prime size must be at least 6 bits")
 	}
@@ -149,7 +155,7 @@ func GetRandomSafePrimesConcurrent(ctx context.Context, bitLen, numPrimes int, c
 	for i := 0; i < concurrency; i++ {
 		waitGroup.Add(1)
 		runGenPrimeRoutine(
-			generatorCtx, primeCh, errCh, waitGroup, rand.Reader, bitLen,
+			generatorCtx, primeCh, errCh, waitGroup, rand, bitLen,
 		)
 	}
 
@@ -12,7 +12,9 @@
 package dlnproof
 
 import (
+	"crypto/rand"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/v2/common"
@@ -33,12 +35,17 @@ var (
 )
 
 func NewDLNProof(h1, h2, x, p, q, N *big.Int) *Proof {
+	return NewDLNProofWithRand(rand.Reader, h1, h2, x, p, q, N)
+}
+
+// NewDLNProofWithRand is NewDLNProof with its randomness drawn from `rand`
+func NewDLNProofWithRand(rand io.Reader, h1, h2, x, p, q, N *big.Int) *Proof {
 	pMulQ := new(big.Int).Mul(p, q)
 	modN, modPQ := common.ModInt(N), common.ModInt(pMulQ)
 	a := make([]*big.Int, Iterations)
 	alpha := [Iterations]*big.Int{}
 	for i := range alpha {
-		a[i] = common.GetRandomPositiveInt(pMulQ)
+		a[i] = common.GetRandomPositiveIntWithRand(rand, pMulQ)
 		alpha[i] = modN.Exp(h1, a[i])
 	}
 	msg := append([]*big.Int{h1, h2, N}, alpha[:]...)
@@ -8,8 +8,10 @@ package facproof
 
 import (
 	"crypto/elliptic"
+	"crypto/rand"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/v2/common"
@@ -33,6 +35,11 @@ var (
 
 // NewProof implements prooffac
 func NewProof(Session []byte, ec elliptic.Curve, N0, NCap, s, t, N0p, N0q *big.Int) (*ProofFac, error) {
+	return NewProofWithRand(rand.Reader, Session, ec, N0, NCap, s, t, N0p, N0q)
+}
+
+// NewProofWithRand is NewProof with its randomness drawn from `rand`
+func NewProofWithRand(rand io.Reader, Session []byte, ec elliptic.Curve, N0, NCap, s, t, N0p, N0q *big.Int) (*ProofFac, error) {
 	if ec == nil || N0 == nil || NCap == nil || s == nil || t == nil || N0p == nil || N0q == nil {
 		return nil, errors.New("ProveFac constructor received nil value(s)")
 	}
@@ -48,14 +55,14 @@ func NewProof(Session []byte, ec elliptic.Curve, N0, NCap, s, t, N0p, N0q *big.I
 	q3SqrtN0 := new(big.Int).Mul(q3, sqrtN0)
 
 	// Fig 28.1 sample
-	alpha := common.GetRandomPositiveInt(q3SqrtN0)
-	beta := common.GetRandomPositiveInt(q3SqrtN0)
-	mu := common.GetRandomPositiveInt(qNCap)
-	nu := common.GetRandomPositiveInt(qNCap)
-	sigma := common.GetRandomPositiveInt(qN0NCap)
-	r := common.GetRandomPositiveRelativelyPrimeInt(q3N0NCap)
-	x := common.GetRandomPositiveInt(q3NCap)
-	y := common.GetRandomPositiveInt(q3NCap)
+	alpha := common.GetRandomPositiveIntWithRand(rand, q3SqrtN0)
+	beta := common.GetRandomPositiveIntWithRand(rand, q3SqrtN0)
+	mu := common.GetRandomPositiveIntWithRand(rand, qNCap)
+	nu := common.GetRandomPositiveIntWithRand(rand, qNCap)
+	sigma := common.GetRandomPositiveIntWithRand(rand, qN0NCap)
+	r := common.GetRandomPositiveRelativelyPrimeIntWithRand(rand, q3N0NCap)
+	x := common.GetRandomPositiveIntWithRand(rand, q3NCap)
+	y := common.GetRandomPositiveIntWithRand(rand, q3NCap)
 
 	// Fig 28.1 compute
 	modNCap := common.ModInt(NCap)
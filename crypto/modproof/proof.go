@@ -7,7 +7,9 @@
 package modproof
 
 import (
+	"crypto/rand"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/v2/common"
@@ -38,9 +40,14 @@ func isQuadraticResidue(X, N *big.Int) bool {
 }
 
 func NewProof(Session []byte, N, P, Q *big.Int) (*ProofMod, error) {
+	return NewProofWithRand(rand.Reader, Session, N, P, Q)
+}
+
+// NewProofWithRand is NewProof with its randomness drawn from `rand`
+func NewProofWithRand(rand io.Reader, Session []byte, N, P, Q *big.Int) (*ProofMod, error) {
 	Phi := new(big.Int).Mul(new(big.Int).Sub(P, one), new(big.Int).Sub(Q, one))
 	// Fig 16.1
-	W := common.GetRandomQuadraticNonResidue(N)
+	W := common.GetRandomQuadraticNonResidueWithRand(rand, N)
 
 	// Fig 16.2
 	Y := [Iterations]*big.Int{}
@@ -8,7 +8,9 @@ package mta
 
 import (
 	"crypto/elliptic"
+	"crypto/rand"
 	"errors"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/v2/common"
@@ -21,12 +23,33 @@ func AliceInit(
 	pkA *paillier.PublicKey,
 	a, NTildeB, h1B, h2B *big.Int,
 ) (cA *big.Int, pf *RangeProofAlice, err error) {
-	cA, rA, err := pkA.EncryptAndReturnRandomness(a)
+	return AliceInitWithRand(rand.Reader, ec, pkA, a, NTildeB, h1B, h2B)
+}
+
+// AliceInitWithRand is AliceInit with its randomness drawn from `rand`
+func AliceInitWithRand(
+	rand io.Reader,
+	ec elliptic.Curve,
+	pkA *paillier.PublicKey,
+	a, NTildeB, h1B, h2B *big.Int,
+) (cA *big.Int, pf *RangeProofAlice, err error) {
+	cA, _, pf, err = AliceInitAndReturnRandomnessWithRand(rand, ec, pkA, a, NTildeB, h1B, h2B)
+	return cA, pf, err
+}
+
+// AliceInitAndReturnRandomnessWithRand is AliceInitWithRand also returning the randomness rA of cA, which opens it
+func AliceInitAndReturnRandomnessWithRand(
+	rand io.Reader,
+	ec elliptic.Curve,
+	pkA *paillier.PublicKey,
+	a, NTildeB, h1B, h2B *big.Int,
+) (cA, rA *big.Int, pf *RangeProofAlice, err error) {
+	cA, rA, err = pkA.EncryptAndReturnRandomnessWithRand(rand, a)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
-	pf, err = ProveRangeAlice(ec, pkA, cA, NTildeB, h1B, h2B, a, rA)
-	return cA, pf, err
+	pf, err = ProveRangeAliceWithRand(rand, ec, pkA, cA, NTildeB, h1B, h2B, a, rA)
+	return cA, rA, pf, err
 }
 
 func BobMid(
@@ -36,6 +59,32 @@ func BobMid(
 	pf *RangeProofAlice,
 	b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B *big.Int,
 ) (beta, cB, betaPrm *big.Int, piB *ProofBob, err error) {
+	return BobMidWithRand(rand.Reader, Session, ec, pkA, pf, b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B)
+}
+
+// BobMidWithRand is BobMid with its randomness drawn from `rand`
+func BobMidWithRand(
+	rand io.Reader,
+	Session []byte,
+	ec elliptic.Curve,
+	pkA *paillier.PublicKey,
+	pf *RangeProofAlice,
+	b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B *big.Int,
+) (beta, cB, betaPrm *big.Int, piB *ProofBob, err error) {
+	beta, cB, betaPrm, _, piB, err = BobMidAndReturnRandomnessWithRand(rand, Session, ec, pkA, pf, b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B)
+	return
+}
+
+// BobMidAndReturnRandomnessWithRand is BobMidWithRand also returning the randomness cRand of the encryption of
+// betaPrm, with which cB = b * cA + Enc(betaPrm, cRand) may be recomputed
+func BobMidAndReturnRandomnessWithRand(
+	rand io.Reader,
+	Session []byte,
+	ec elliptic.Curve,
+	pkA *paillier.PublicKey,
+	pf *RangeProofAlice,
+	b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B *big.Int,
+) (beta, cB, betaPrm, cRand *big.Int, piB *ProofBob, err error) {
 	if !pf.Verify(ec, pkA, NTildeB, h1B, h2B, cA) {
 		err = errors.New("RangeProofAlice.Verify() returned false")
 		return
@@ -44,8 +93,8 @@ func BobMid(
 	q5 := new(big.Int).Mul(q, q)  // q^2
 	q5 = new(big.Int).Mul(q5, q5) // q^4
 	q5 = new(big.Int).Mul(q5, q)  // q^5
-	betaPrm = common.GetRandomPositiveInt(q5)
-	cBetaPrm, cRand, err := pkA.EncryptAndReturnRandomness(betaPrm)
+	betaPrm = common.GetRandomPositiveIntWithRand(rand, q5)
+	cBetaPrm, cRand, err := pkA.EncryptAndReturnRandomnessWithRand(rand, betaPrm)
 	if err != nil {
 		return
 	}
@@ -58,7 +107,7 @@ func BobMid(
 		return
 	}
 	beta = common.ModInt(q).Sub(zero, betaPrm)
-	piB, err = ProveBob(Session, ec, pkA, NTildeA, h1A, h2A, cA, cB, b, betaPrm, cRand)
+	piB, err = ProveBobWithRand(rand, Session, ec, pkA, NTildeA, h1A, h2A, cA, cB, b, betaPrm, cRand)
 	return
 }
 
@@ -69,6 +118,19 @@ func BobMidWC(
 	pf *RangeProofAlice,
 	b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B *big.Int,
 	B *crypto.ECPoint,
+) (beta, cB, betaPrm *big.Int, piB *ProofBobWC, err error) {
+	return BobMidWCWithRand(rand.Reader, Session, ec, pkA, pf, b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B, B)
+}
+
+// BobMidWCWithRand is BobMidWC with its randomness drawn from `rand`
+func BobMidWCWithRand(
+	rand io.Reader,
+	Session []byte,
+	ec elliptic.Curve,
+	pkA *paillier.PublicKey,
+	pf *RangeProofAlice,
+	b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B *big.Int,
+	B *crypto.ECPoint,
 ) (beta, cB, betaPrm *big.Int, piB *ProofBobWC, err error) {
 	if !pf.Verify(ec, pkA, NTildeB, h1B, h2B, cA) {
 		err = errors.New("RangeProofAlice.Verify() returned false")
@@ -78,8 +140,8 @@ func BobMidWC(
 	q5 := new(big.Int).Mul(q, q)  // q^2
 	q5 = new(big.Int).Mul(q5, q5) // q^4
 	q5 = new(big.Int).Mul(q5, q)  // q^5
-	betaPrm = common.GetRandomPositiveInt(q5)
-	cBetaPrm, cRand, err := pkA.EncryptAndReturnRandomness(betaPrm)
+	betaPrm = common.GetRandomPositiveIntWithRand(rand, q5)
+	cBetaPrm, cRand, err := pkA.EncryptAndReturnRandomnessWithRand(rand, betaPrm)
 	if err != nil {
 		return
 	}
@@ -92,7 +154,7 @@ func BobMidWC(
 		return
 	}
 	beta = common.ModInt(q).Sub(zero, betaPrm)
-	piB, err = ProveBobWC(Session, ec, pkA, NTildeA, h1A, h2A, cA, cB, b, betaPrm, cRand, B)
+	piB, err = ProveBobWCWithRand(rand, Session, ec, pkA, NTildeA, h1A, h2A, cA, cB, b, betaPrm, cRand, B)
 	return
 }
 
@@ -17,8 +17,10 @@ package paillier
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
+	"io"
 	gmath "math"
 	"math/big"
 	"runtime"
@@ -67,6 +69,11 @@ func init() {
 
 // len is the length of the modulus (each prime = len / 2)
 func GenerateKeyPair(ctx context.Context, modulusBitLen int, optionalConcurrency ...int) (privateKey *PrivateKey, publicKey *PublicKey, err error) {
+	return GenerateKeyPairWithRand(ctx, rand.Reader, modulusBitLen, optionalConcurrency...)
+}
+
+// GenerateKeyPairWithRand is GenerateKeyPair with its randomness drawn from `rand`
+func GenerateKeyPairWithRand(ctx context.Context, rand io.Reader, modulusBitLen int, optionalConcurrency ...int) (privateKey *PrivateKey, publicKey *PublicKey, err error) {
 	var concurrency int
 	if 0 < len(optionalConcurrency) {
 		if 1 < len(optionalConcurrency) {
@@ -82,7 +89,7 @@ func GenerateKeyPair(ctx context.Context, modulusBitLen int, optionalConcurrency
 	{
 		tmp := new(big.Int)
 		for {
-			sgps, err := common.GetRandomSafePrimesConcurrent(ctx, modulusBitLen/2, 2, concurrency)
+			sgps, err := common.GetRandomSafePrimesConcurrentWithRand(ctx, rand, modulusBitLen/2, 2, concurrency)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -111,10 +118,28 @@ func GenerateKeyPair(ctx context.Context, modulusBitLen int, optionalConcurrency
 // ----- //
 
 func (publicKey *PublicKey) EncryptAndReturnRandomness(m *big.Int) (c *big.Int, x *big.Int, err error) {
+	return publicKey.EncryptAndReturnRandomnessWithRand(rand.Reader, m)
+}
+
+// EncryptAndReturnRandomnessWithRand is EncryptAndReturnRandomness with its randomness drawn from `rand`
+func (publicKey *PublicKey) EncryptAndReturnRandomnessWithRand(rand io.Reader, m *big.Int) (c *big.Int, x *big.Int, err error) {
 	if m.Cmp(zero) == -1 || m.Cmp(publicKey.N) != -1 { // m < 0 || m >= N ?
 		return nil, nil, ErrMessageTooLong
 	}
-	x = common.GetRandomPositiveRelativelyPrimeInt(publicKey.N)
+	x = common.GetRandomPositiveRelativelyPrimeIntWithRand(rand, publicKey.N)
+	c, err = publicKey.EncryptWithRandomness(m, x)
+	return
+}
+
+// EncryptWithRandomness encrypts `m` with the randomness `x` returned by EncryptAndReturnRandomness, e.g. to check
+// that a revealed plaintext and randomness open a ciphertext
+func (publicKey *PublicKey) EncryptWithRandomness(m, x *big.Int) (c *big.Int, err error) {
+	if m.Cmp(zero) == -1 || m.Cmp(publicKey.N) != -1 { // m < 0 || m >= N ?
+		return nil, ErrMessageTooLong
+	}
+	if x.Cmp(zero) != 1 || x.Cmp(publicKey.N) != -1 { // x <= 0 || x >= N ?
+		return nil, ErrMessageMalFormed
+	}
 	N2 := publicKey.NSquare()
 	// 1. gamma^m mod N2
 	Gm := new(big.Int).Exp(publicKey.Gamma(), m, N2)
@@ -126,7 +151,12 @@ func (publicKey *PublicKey) EncryptAndReturnRandomness(m *big.Int) (c *big.Int,
 }
 
 func (publicKey *PublicKey) Encrypt(m *big.Int) (c *big.Int, err error) {
-	c, _, err = publicKey.EncryptAndReturnRandomness(m)
+	return publicKey.EncryptWithRand(rand.Reader, m)
+}
+
+// EncryptWithRand is Encrypt with its randomness drawn from `rand`
+func (publicKey *PublicKey) EncryptWithRand(rand io.Reader, m *big.Int) (c *big.Int, err error) {
+	c, _, err = publicKey.EncryptAndReturnRandomnessWithRand(rand, m)
 	return
 }
 
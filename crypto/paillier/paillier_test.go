@@ -58,6 +58,20 @@ func TestEncrypt(t *testing.T) {
 	t.Log(cipher)
 }
 
+func TestEncryptWithRandomness(t *testing.T) {
+	setUp(t)
+	cipher, x, err := publicKey.EncryptAndReturnRandomness(big.NewInt(1))
+	assert.NoError(t, err, "must not error")
+	again, err := publicKey.EncryptWithRandomness(big.NewInt(1), x)
+	assert.NoError(t, err, "must not error")
+	assert.Equal(t, 0, cipher.Cmp(again), "the randomness must open the ciphertext")
+	other, err := publicKey.EncryptWithRandomness(big.NewInt(2), x)
+	assert.NoError(t, err, "must not error")
+	assert.NotEqual(t, 0, cipher.Cmp(other))
+	_, err = publicKey.EncryptWithRandomness(big.NewInt(1), big.NewInt(0))
+	assert.Error(t, err, "the randomness must be in [1, N)")
+}
+
 func TestEncryptDecrypt(t *testing.T) {
 	setUp(t)
 	exp := big.NewInt(100)
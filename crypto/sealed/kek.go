@@ -0,0 +1,147 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package sealed
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+)
+
+const (
+	PassphraseKeyID = "argon2id"
+
+	// the Argon2id parameters recommended by RFC 9106 for memory-constrained environments
+	argon2Time    = 3
+	argon2Memory  = 64 * 1024 // KiB
+	argon2Threads = 4
+	argon2SaltLen = 16
+
+	// time, memory and threads precede the salt in a wrapped key
+	argon2HeaderLen = 4 + 4 + 1
+
+	// upper bounds on the parameters read from a container, which must not be able to exhaust the machine
+	argon2MaxTime   = 64
+	argon2MaxMemory = 256 * 1024 // KiB
+)
+
+type (
+	passphraseKEK struct {
+		passphrase []byte
+	}
+
+	aesKEK struct {
+		id  string
+		key []byte
+	}
+)
+
+var (
+	_ KeyEncryptionKey = (*passphraseKEK)(nil)
+	_ KeyEncryptionKey = (*aesKEK)(nil)
+)
+
+// NewPassphraseKEK returns a KeyEncryptionKey derived from `passphrase` with Argon2id. A new salt is chosen each time
+// a container is sealed; the salt and the Argon2id parameters are stored with the wrapped key.
+func NewPassphraseKEK(passphrase []byte) (KeyEncryptionKey, error) {
+	if len(passphrase) == 0 {
+		return nil, errors.New("the passphrase must not be empty")
+	}
+	return &passphraseKEK{passphrase: append([]byte(nil), passphrase...)}, nil
+}
+
+func (kek *passphraseKEK) ID() string {
+	return PassphraseKeyID
+}
+
+func (kek *passphraseKEK) WrapKey(dataKey, aad []byte) ([]byte, error) {
+	salt, err := common.GetRandomBytes(argon2SaltLen)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, argon2HeaderLen, argon2HeaderLen+argon2SaltLen)
+	binary.BigEndian.PutUint32(header[0:], argon2Time)
+	binary.BigEndian.PutUint32(header[4:], argon2Memory)
+	header[8] = argon2Threads
+	header = append(header, salt...)
+
+	key := argon2.IDKey(kek.passphrase, salt, argon2Time, argon2Memory, argon2Threads, dataKeySize)
+	nonce, ciphertext, err := encrypt(key, dataKey, append(header, aad...))
+	if err != nil {
+		return nil, err
+	}
+	return append(append(header, nonce...), ciphertext...), nil
+}
+
+func (kek *passphraseKEK) UnwrapKey(wrapped, aad []byte) ([]byte, error) {
+	if len(wrapped) < argon2HeaderLen+argon2SaltLen {
+		return nil, errors.New("the wrapped key is too short")
+	}
+	time, memory, threads := binary.BigEndian.Uint32(wrapped[0:]), binary.BigEndian.Uint32(wrapped[4:]), wrapped[8]
+	if time == 0 || argon2MaxTime < time || threads == 0 || memory < 8*uint32(threads) || argon2MaxMemory < memory {
+		return nil, errors.New("invalid argon2id parameters")
+	}
+	header := wrapped[:argon2HeaderLen+argon2SaltLen]
+	salt := header[argon2HeaderLen:]
+	rest := wrapped[len(header):]
+
+	key := argon2.IDKey(kek.passphrase, salt, time, memory, threads, dataKeySize)
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < aead.NonceSize() {
+		return nil, errors.New("the wrapped key is too short")
+	}
+	ad := append(append([]byte(nil), header...), aad...)
+	dataKey, err := decrypt(key, rest[:aead.NonceSize()], rest[aead.NonceSize():], ad)
+	if err != nil {
+		return nil, errors.New("wrong passphrase or corrupted container")
+	}
+	return dataKey, nil
+}
+
+// NewAESKEK returns a KeyEncryptionKey which wraps data keys with AES-GCM under a 16, 24 or 32 byte `key`.
+// The `id` names the key in the containers that it seals.
+func NewAESKEK(id string, key []byte) (KeyEncryptionKey, error) {
+	switch len(key) {
+	case 16, 24, 32:
+	default:
+		return nil, fmt.Errorf("invalid AES key size %d", len(key))
+	}
+	if id == "" || id == PassphraseKeyID {
+		return nil, fmt.Errorf("invalid key ID %q", id)
+	}
+	return &aesKEK{id: id, key: append([]byte(nil), key...)}, nil
+}
+
+func (kek *aesKEK) ID() string {
+	return kek.id
+}
+
+func (kek *aesKEK) WrapKey(dataKey, aad []byte) ([]byte, error) {
+	nonce, ciphertext, err := encrypt(kek.key, dataKey, aad)
+	if err != nil {
+		return nil, err
+	}
+	return append(nonce, ciphertext...), nil
+}
+
+func (kek *aesKEK) UnwrapKey(wrapped, aad []byte) ([]byte, error) {
+	aead, err := newAEAD(kek.key)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, errors.New("the wrapped key is too short")
+	}
+	return decrypt(kek.key, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], aad)
+}
@@ -0,0 +1,211 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package sealed implements an encrypted-at-rest container for key data. The contents are encrypted with AES-256-GCM
+// under a random data key, which is wrapped by a KeyEncryptionKey: either one derived from a passphrase with Argon2id
+// or one supplied by the caller, such as a key held in a KMS. The metadata of the container is stored in the clear and
+// authenticated by the encryption.
+package sealed
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	// ContainerVersion is the version of the container format written by Seal
+	ContainerVersion = 1
+
+	dataKeySize = 32
+)
+
+type (
+	// Metadata describes the contents of a container. It is readable without the key but cannot be altered.
+	Metadata struct {
+		ContentType string
+		Curve       tss.CurveName
+		PublicKey   *crypto.ECPoint
+		Epoch       uint64
+	}
+
+	// KeyEncryptionKey wraps the data key of a container
+	KeyEncryptionKey interface {
+		// ID identifies the key; it is recorded in the container so that it can be opened with the same key
+		ID() string
+		// WrapKey encrypts and authenticates `dataKey` together with `aad`
+		WrapKey(dataKey, aad []byte) ([]byte, error)
+		// UnwrapKey reverses WrapKey, failing if `wrapped` or `aad` were altered
+		UnwrapKey(wrapped, aad []byte) ([]byte, error)
+	}
+)
+
+// Seal encrypts `plaintext` into a container described by `md` under a new data key wrapped with `kek`
+func Seal(plaintext []byte, md Metadata, kek KeyEncryptionKey) ([]byte, error) {
+	mdBzs, err := md.marshal()
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := common.GetRandomBytes(dataKeySize)
+	if err != nil {
+		return nil, err
+	}
+	aad := associatedData(kek.ID(), mdBzs)
+	wrapped, err := kek.WrapKey(dataKey, aad)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap the data key: %w", err)
+	}
+	nonce, ciphertext, err := encrypt(dataKey, plaintext, aad)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(&Container{
+		Version:    ContainerVersion,
+		Metadata:   mdBzs,
+		KekId:      kek.ID(),
+		WrappedKey: wrapped,
+		Nonce:      nonce,
+		Ciphertext: ciphertext,
+	})
+}
+
+// Open decrypts a container created by Seal with the same `kek`, returning its contents and metadata
+func Open(container []byte, kek KeyEncryptionKey) ([]byte, *Metadata, error) {
+	c, err := unmarshalContainer(container)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c.GetKekId() != kek.ID() {
+		return nil, nil, fmt.Errorf("the container was sealed with key %q, not %q", c.GetKekId(), kek.ID())
+	}
+	md, err := unmarshalMetadata(c.GetMetadata())
+	if err != nil {
+		return nil, nil, err
+	}
+	aad := associatedData(c.GetKekId(), c.GetMetadata())
+	dataKey, err := kek.UnwrapKey(c.GetWrappedKey(), aad)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to unwrap the data key: %w", err)
+	}
+	plaintext, err := decrypt(dataKey, c.GetNonce(), c.GetCiphertext(), aad)
+	if err != nil {
+		return nil, nil, err
+	}
+	return plaintext, md, nil
+}
+
+// ReadMetadata returns the metadata of a container without opening it. It is only authenticated by Open.
+func ReadMetadata(container []byte) (*Metadata, error) {
+	c, err := unmarshalContainer(container)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalMetadata(c.GetMetadata())
+}
+
+// ----- //
+
+func (md Metadata) marshal() ([]byte, error) {
+	if md.ContentType == "" {
+		return nil, errors.New("the metadata must have a content type")
+	}
+	cmd := &ContainerMetadata{
+		ContentType: md.ContentType,
+		Curve:       string(md.Curve),
+		Epoch:       md.Epoch,
+	}
+	if md.PublicKey != nil {
+		cmd.PublicKeyX, cmd.PublicKeyY = md.PublicKey.X().Bytes(), md.PublicKey.Y().Bytes()
+	}
+	return proto.Marshal(cmd)
+}
+
+func unmarshalMetadata(bz []byte) (*Metadata, error) {
+	cmd := new(ContainerMetadata)
+	if err := proto.Unmarshal(bz, cmd); err != nil {
+		return nil, err
+	}
+	md := &Metadata{
+		ContentType: cmd.GetContentType(),
+		Curve:       tss.CurveName(cmd.GetCurve()),
+		Epoch:       cmd.GetEpoch(),
+	}
+	if len(cmd.GetPublicKeyX()) > 0 {
+		ec, ok := tss.GetCurveByName(md.Curve)
+		if !ok {
+			return nil, fmt.Errorf("unknown curve %q", md.Curve)
+		}
+		pub, err := crypto.NewECPoint(ec, new(big.Int).SetBytes(cmd.GetPublicKeyX()), new(big.Int).SetBytes(cmd.GetPublicKeyY()))
+		if err != nil {
+			return nil, err
+		}
+		md.PublicKey = pub
+	}
+	return md, nil
+}
+
+func unmarshalContainer(bz []byte) (*Container, error) {
+	c := new(Container)
+	if err := proto.Unmarshal(bz, c); err != nil {
+		return nil, err
+	}
+	if c.GetVersion() == 0 || ContainerVersion < c.GetVersion() {
+		return nil, fmt.Errorf("unsupported container version %d", c.GetVersion())
+	}
+	return c, nil
+}
+
+// associatedData binds the container version, the key ID and the metadata to the ciphertext and the wrapped key
+func associatedData(kekID string, metadata []byte) []byte {
+	aad := make([]byte, 5, 5+len(kekID)+len(metadata))
+	aad[0] = ContainerVersion
+	binary.BigEndian.PutUint32(aad[1:], uint32(len(kekID)))
+	aad = append(aad, kekID...)
+	return append(aad, metadata...)
+}
+
+func encrypt(key, plaintext, aad []byte) (nonce, ciphertext []byte, err error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if nonce, err = common.GetRandomBytes(aead.NonceSize()); err != nil {
+		return nil, nil, err
+	}
+	return nonce, aead.Seal(nil, nonce, plaintext, aad), nil
+}
+
+func decrypt(key, nonce, ciphertext, aad []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, errors.New("invalid nonce")
+	}
+	plaintext, err := aead.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, errors.New("the container could not be authenticated")
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
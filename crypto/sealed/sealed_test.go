@@ -0,0 +1,119 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package sealed
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func testMetadata() Metadata {
+	return Metadata{
+		ContentType: "test",
+		Curve:       tss.Secp256k1,
+		PublicKey:   crypto.ScalarBaseMult(tss.S256(), common.GetRandomPositiveInt(tss.S256().Params().N)),
+		Epoch:       3,
+	}
+}
+
+func TestSealOpenPassphrase(t *testing.T) {
+	md := testMetadata()
+	kek, err := NewPassphraseKEK([]byte("correct horse battery staple"))
+	assert.NoError(t, err)
+
+	container, err := Seal([]byte("secret"), md, kek)
+	assert.NoError(t, err)
+
+	plaintext, opened, err := Open(container, kek)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("secret"), plaintext)
+	assert.Equal(t, md.ContentType, opened.ContentType)
+	assert.Equal(t, md.Epoch, opened.Epoch)
+	assert.True(t, md.PublicKey.Equals(opened.PublicKey))
+
+	read, err := ReadMetadata(container)
+	assert.NoError(t, err)
+	assert.True(t, md.PublicKey.Equals(read.PublicKey))
+
+	wrong, err := NewPassphraseKEK([]byte("incorrect horse battery staple"))
+	assert.NoError(t, err)
+	_, _, err = Open(container, wrong)
+	assert.Error(t, err, "a wrong passphrase must fail")
+}
+
+func TestSealOpenAESKEK(t *testing.T) {
+	md := testMetadata()
+	key, err := common.GetRandomBytes(32)
+	assert.NoError(t, err)
+	kek, err := NewAESKEK("kms/key-1", key)
+	assert.NoError(t, err)
+
+	container, err := Seal([]byte("secret"), md, kek)
+	assert.NoError(t, err)
+	plaintext, _, err := Open(container, kek)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("secret"), plaintext)
+
+	other, err := NewAESKEK("kms/key-2", key)
+	assert.NoError(t, err)
+	_, _, err = Open(container, other)
+	assert.Error(t, err, "a container must be opened with the key it was sealed with")
+
+	_, err = NewAESKEK("kms/key-3", key[:10])
+	assert.Error(t, err)
+}
+
+func TestOpenTampered(t *testing.T) {
+	md := testMetadata()
+	key, err := common.GetRandomBytes(32)
+	assert.NoError(t, err)
+	kek, err := NewAESKEK("kms/key-1", key)
+	assert.NoError(t, err)
+	container, err := Seal([]byte("secret"), md, kek)
+	assert.NoError(t, err)
+
+	tamper := func(f func(c *Container)) []byte {
+		c := new(Container)
+		assert.NoError(t, proto.Unmarshal(container, c))
+		f(c)
+		bz, err := proto.Marshal(c)
+		assert.NoError(t, err)
+		return bz
+	}
+
+	// the metadata is authenticated
+	md.Epoch++
+	mdBzs, err := md.marshal()
+	assert.NoError(t, err)
+	_, _, err = Open(tamper(func(c *Container) { c.Metadata = mdBzs }), kek)
+	assert.Error(t, err)
+
+	_, _, err = Open(tamper(func(c *Container) { c.Ciphertext[0] ^= 1 }), kek)
+	assert.Error(t, err)
+
+	_, _, err = Open(tamper(func(c *Container) { c.Version = ContainerVersion + 1 }), kek)
+	assert.Error(t, err)
+}
+
+func TestPassphraseKEKRejectsExcessiveParameters(t *testing.T) {
+	kek, err := NewPassphraseKEK([]byte("correct horse battery staple"))
+	assert.NoError(t, err)
+	wrapped, err := kek.WrapKey(make([]byte, dataKeySize), nil)
+	assert.NoError(t, err)
+
+	// a crafted header must not make Open allocate more than argon2MaxMemory
+	binary.BigEndian.PutUint32(wrapped[4:], argon2MaxMemory+1)
+	_, err = kek.UnwrapKey(wrapped, nil)
+	assert.Error(t, err)
+}
@@ -12,8 +12,10 @@ package vss
 
 import (
 	"crypto/elliptic"
+	"crypto/rand"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/v2/common"
@@ -59,6 +61,11 @@ func CheckIndexes(ec elliptic.Curve, indexes []*big.Int) ([]*big.Int, error) {
 // Returns a new array of secret shares created by Shamir's Secret Sharing Algorithm,
 // requiring a minimum number of shares to recreate, of length shares, from the input secret
 func Create(ec elliptic.Curve, threshold int, secret *big.Int, indexes []*big.Int) (Vs, Shares, error) {
+	return CreateWithRand(rand.Reader, ec, threshold, secret, indexes)
+}
+
+// CreateWithRand is Create with its randomness drawn from `rand`
+func CreateWithRand(rand io.Reader, ec elliptic.Curve, threshold int, secret *big.Int, indexes []*big.Int) (Vs, Shares, error) {
 	if secret == nil || indexes == nil {
 		return nil, nil, fmt.Errorf("vss secret or indexes == nil: %v %v", secret, indexes)
 	}
@@ -76,7 +83,7 @@ func Create(ec elliptic.Curve, threshold int, secret *big.Int, indexes []*big.In
 		return nil, nil, ErrNumSharesBelowThreshold
 	}
 
-	poly := samplePolynomial(ec, threshold, secret)
+	poly := samplePolynomial(rand, ec, threshold, secret)
 	poly[0] = secret // becomes sigma*G in v
 	v := make(Vs, len(poly))
 	for i, ai := range poly {
@@ -144,12 +151,12 @@ func (shares Shares) ReConstruct(ec elliptic.Curve) (secret *big.Int, err error)
 	return secret, nil
 }
 
-func samplePolynomial(ec elliptic.Curve, threshold int, secret *big.Int) []*big.Int {
+func samplePolynomial(rand io.Reader, ec elliptic.Curve, threshold int, secret *big.Int) []*big.Int {
 	q := ec.Params().N
 	v := make([]*big.Int, threshold+1)
 	v[0] = secret
 	for i := 1; i <= threshold; i++ {
-		ai := common.GetRandomPositiveInt(q)
+		ai := common.GetRandomPositiveIntWithRand(rand, q)
 		v[i] = ai
 	}
 	return v
@@ -172,3 +179,110 @@ func evaluatePolynomial(ec elliptic.Curve, threshold int, v []*big.Int, id *big.
 	}
 	return
 }
+
+// ----- //
+
+// CreateZeroSharing returns Shamir shares of zero, as used to re-randomize the shares of an existing key.
+// The commitment to the constant term would be the point at infinity and is omitted, so the returned Vs holds v1..vt.
+// Use VerifyZeroShare to check a share against it.
+func CreateZeroSharing(rand io.Reader, ec elliptic.Curve, threshold int, indexes []*big.Int) (Vs, Shares, error) {
+	if indexes == nil {
+		return nil, nil, errors.New("vss indexes == nil")
+	}
+	if threshold < 1 {
+		return nil, nil, errors.New("vss threshold < 1")
+	}
+
+	ids, err := CheckIndexes(ec, indexes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	num := len(indexes)
+	if num < threshold {
+		return nil, nil, ErrNumSharesBelowThreshold
+	}
+
+	poly := samplePolynomial(rand, ec, threshold, zero)
+	v := make(Vs, threshold)
+	for i := 1; i <= threshold; i++ {
+		v[i-1] = crypto.ScalarBaseMult(ec, poly[i])
+	}
+
+	shares := make(Shares, num)
+	for i := 0; i < num; i++ {
+		share := evaluatePolynomial(ec, threshold, poly, ids[i])
+		shares[i] = &Share{Threshold: threshold, ID: ids[i], Share: share}
+	}
+	return v, shares, nil
+}
+
+// EvaluateZeroCommitments returns f(id)*G for the zero sharing committed to by vs = v1..vt
+func EvaluateZeroCommitments(ec elliptic.Curve, vs Vs, id *big.Int) (*crypto.ECPoint, error) {
+	if len(vs) == 0 {
+		return nil, errors.New("vss commitments are empty")
+	}
+	var err error
+	modQ := common.ModInt(ec.Params().N)
+	t := new(big.Int).Mod(id, ec.Params().N)
+	v := vs[0].SetCurve(ec).ScalarMult(t)
+	for j := 1; j < len(vs); j++ {
+		// t = id^(j+1)
+		t = modQ.Mul(t, id)
+		if v, err = v.Add(vs[j].SetCurve(ec).ScalarMult(t)); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+func (share *Share) VerifyZeroShare(ec elliptic.Curve, threshold int, vs Vs) bool {
+	if share.Threshold != threshold || len(vs) != threshold {
+		return false
+	}
+	v, err := EvaluateZeroCommitments(ec, vs, share.ID)
+	if err != nil {
+		return false
+	}
+	sigmaGi := crypto.ScalarBaseMult(ec, share.Share)
+	return sigmaGi.Equals(v)
+}
+
+// ----- //
+
+// LagrangeCoefficient returns the coefficient of the share with ID ids[i] when interpolating the shared polynomial at x
+// from the shares with the given ids. Interpolating at zero recovers the secret, as in ReConstruct.
+func LagrangeCoefficient(ec elliptic.Curve, ids []*big.Int, i int, x *big.Int) (*big.Int, error) {
+	if i < 0 || len(ids) <= i {
+		return nil, fmt.Errorf("vss share index %d out of range", i)
+	}
+	if _, err := CheckIndexes(ec, ids); err != nil {
+		return nil, err
+	}
+	modN := common.ModInt(ec.Params().N)
+	num, den := big.NewInt(1), big.NewInt(1)
+	for j, id := range ids {
+		if j == i {
+			continue
+		}
+		num = modN.Mul(num, modN.Sub(x, id))
+		den = modN.Mul(den, modN.Sub(ids[i], id))
+	}
+	return modN.Mul(num, modN.ModInverse(den)), nil
+}
+
+// SplitAdditive returns n random values which sum to secret mod N
+func SplitAdditive(rand io.Reader, ec elliptic.Curve, secret *big.Int, n int) ([]*big.Int, error) {
+	if n < 1 {
+		return nil, errors.New("vss cannot split into fewer than one part")
+	}
+	modN := common.ModInt(ec.Params().N)
+	parts := make([]*big.Int, n)
+	last := new(big.Int).Mod(secret, ec.Params().N)
+	for k := 0; k < n-1; k++ {
+		parts[k] = common.GetRandomPositiveIntWithRand(rand, ec.Params().N)
+		last = modN.Sub(last, parts[k])
+	}
+	parts[n-1] = last
+	return parts, nil
+}
@@ -7,6 +7,7 @@
 package vss_test
 
 import (
+	"crypto/rand"
 	"math/big"
 	"testing"
 
@@ -114,3 +115,67 @@ func TestReconstruct(t *testing.T) {
 	assert.NoError(t, err4)
 	assert.NotZero(t, secret4)
 }
+
+func TestCreateZeroSharing(t *testing.T) {
+	num, threshold := 5, 3
+
+	ids := make([]*big.Int, 0)
+	for i := 0; i < num; i++ {
+		ids = append(ids, common.GetRandomPositiveInt(tss.EC().Params().N))
+	}
+
+	vs, shares, err := CreateZeroSharing(rand.Reader, tss.EC(), threshold, ids)
+	assert.NoError(t, err)
+	assert.Equal(t, threshold, len(vs))
+
+	for i := 0; i < num; i++ {
+		assert.True(t, shares[i].VerifyZeroShare(tss.EC(), threshold, vs))
+	}
+	tampered := &Share{Threshold: threshold, ID: shares[0].ID, Share: new(big.Int).Add(shares[0].Share, big.NewInt(1))}
+	assert.False(t, tampered.VerifyZeroShare(tss.EC(), threshold, vs))
+
+	// any threshold+1 of the shares reconstruct zero
+	secret, err := shares[:threshold+1].ReConstruct(tss.EC())
+	assert.NoError(t, err)
+	assert.Zero(t, secret.Sign())
+}
+
+func TestLagrangeCoefficient(t *testing.T) {
+	num, threshold := 5, 3
+
+	ids := make([]*big.Int, 0)
+	for i := 0; i < num; i++ {
+		ids = append(ids, common.GetRandomPositiveInt(tss.EC().Params().N))
+	}
+	secret := common.GetRandomPositiveInt(tss.EC().Params().N)
+	_, shares, err := Create(tss.EC(), threshold, secret, ids)
+	assert.NoError(t, err)
+
+	// the shares of the first threshold+1 parties interpolate the share of the last party
+	modN := common.ModInt(tss.EC().Params().N)
+	subset := ids[:threshold+1]
+	lost := big.NewInt(0)
+	for i := range subset {
+		lambda, err := LagrangeCoefficient(tss.EC(), subset, i, ids[num-1])
+		assert.NoError(t, err)
+		lost = modN.Add(lost, modN.Mul(lambda, shares[i].Share))
+	}
+	assert.Equal(t, 0, lost.Cmp(shares[num-1].Share))
+
+	_, err = LagrangeCoefficient(tss.EC(), subset, len(subset), ids[num-1])
+	assert.Error(t, err)
+}
+
+func TestSplitAdditive(t *testing.T) {
+	secret := common.GetRandomPositiveInt(tss.EC().Params().N)
+	parts, err := SplitAdditive(rand.Reader, tss.EC(), secret, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(parts))
+
+	modN := common.ModInt(tss.EC().Params().N)
+	sum := big.NewInt(0)
+	for _, part := range parts {
+		sum = modN.Add(sum, part)
+	}
+	assert.Equal(t, 0, sum.Cmp(secret))
+}
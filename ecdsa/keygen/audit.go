@@ -0,0 +1,147 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// AuditTranscript verifies the public proofs in the transcript of a keygen party: the DLN proofs of round 1, the
+// mod and fac proofs of round 2 and the Paillier proofs of round 3. No secret is needed. The fac proofs are sent
+// point-to-point, so a transcript only holds those sent and received by the party that recorded it, and the proofs
+// of rounds that a failed session did not reach are missing too. The returned error names every party that sent an
+// invalid proof, or is nil when the proofs in the transcript are all valid.
+func AuditTranscript(t *tss.Transcript) *tss.Error {
+	params, err := t.Parameters()
+	if err != nil {
+		return tss.NewError(err, TaskName, -1, nil)
+	}
+	msgs, err := t.Messages()
+	if err != nil {
+		return tss.NewError(err, TaskName, -1, nil)
+	}
+	Ps := params.Parties().IDs()
+	r1msgs := make([]*KGRound1Message, len(Ps))
+	r2msg2s := make([]*KGRound2Message2, len(Ps))
+	r3msgs := make([]*KGRound3Message, len(Ps))
+	type facMsg struct {
+		from, to int
+		content  *KGRound2Message1
+	}
+	var facMsgs []facMsg
+	for _, m := range msgs {
+		j := m.Msg.GetFrom().Index
+		switch content := m.Msg.Content().(type) {
+		case *KGRound1Message:
+			r1msgs[j] = content
+		case *KGRound2Message1:
+			to := params.PartyID().Index
+			if m.Sent {
+				if len(m.To) != 1 {
+					continue
+				}
+				to = m.To[0].Index
+			}
+			facMsgs = append(facMsgs, facMsg{j, to, content})
+		case *KGRound2Message2:
+			r2msg2s[j] = content
+		case *KGRound3Message:
+			r3msgs[j] = content
+		}
+	}
+
+	round := &base{Parameters: params, temp: &localTempData{ssidNonce: params.SSIDNonce()}, number: 1}
+	ssid, err := round.getSSID()
+	if err != nil {
+		return round.WrapError(err)
+	}
+	audit := tss.NewTranscriptAudit(TaskName, params.PartyID())
+
+	// round 1: DLN proofs
+	for j, r1msg := range r1msgs {
+		if r1msg == nil {
+			continue
+		}
+		H1j, H2j, NTildej := r1msg.UnmarshalH1(), r1msg.UnmarshalH2(), r1msg.UnmarshalNTilde()
+		if proof, err := r1msg.UnmarshalDLNProof1(); err != nil || !proof.Verify(H1j, H2j, NTildej) {
+			audit.Fail(1, Ps[j], errors.New("dln proof 1 verify failed"))
+		}
+		if proof, err := r1msg.UnmarshalDLNProof2(); err != nil || !proof.Verify(H2j, H1j, NTildej) {
+			audit.Fail(1, Ps[j], errors.New("dln proof 2 verify failed"))
+		}
+	}
+
+	// round 2: fac proofs to each recipient and mod proofs
+	for _, fm := range facMsgs {
+		j, k := fm.from, fm.to
+		if r1msgs[j] == nil || r1msgs[k] == nil {
+			continue
+		}
+		proof, err := fm.content.UnmarshalFacProof()
+		if err != nil && params.NoProofFac() {
+			continue
+		}
+		ContextJ := common.AppendBigIntToBytesSlice(ssid, big.NewInt(int64(j)))
+		if err != nil || !proof.Verify(ContextJ, params.EC(), r1msgs[j].UnmarshalPaillierPK().N,
+			r1msgs[k].UnmarshalNTilde(), r1msgs[k].UnmarshalH1(), r1msgs[k].UnmarshalH2()) {
+			audit.Fail(2, Ps[j], fmt.Errorf("fac proof to party %s verify failed", Ps[k]))
+		}
+	}
+	ecdsaPub := (*crypto.ECPoint)(nil)
+	for j, r2msg2 := range r2msg2s {
+		if r2msg2 == nil || r1msgs[j] == nil {
+			continue
+		}
+		ContextJ := common.AppendBigIntToBytesSlice(ssid, big.NewInt(int64(j)))
+		proof, err := r2msg2.UnmarshalModProof()
+		if err == nil || !params.NoProofMod() {
+			if err != nil || !proof.Verify(ContextJ, r1msgs[j].UnmarshalPaillierPK().N) {
+				audit.Fail(2, Ps[j], errors.New("mod proof verify failed"))
+			}
+		}
+		// the public key is needed for the Paillier proofs of round 3
+		cmtDeCmt := commitments.HashCommitDecommit{C: r1msgs[j].UnmarshalCommitment(), D: r2msg2.UnmarshalDeCommitment()}
+		ok, flatPolyGs := cmtDeCmt.DeCommit()
+		if !ok || flatPolyGs == nil {
+			audit.Fail(2, Ps[j], errors.New("de-commitment verify failed"))
+			continue
+		}
+		PjVs, err := crypto.UnFlattenECPoints(params.EC(), flatPolyGs)
+		if err != nil {
+			audit.Fail(2, Ps[j], err)
+			continue
+		}
+		// the zero sharings of an import have no constant term
+		if len(PjVs) != params.Threshold()+1 {
+			continue
+		}
+		if ecdsaPub == nil {
+			ecdsaPub = PjVs[0]
+		} else if ecdsaPub, err = ecdsaPub.Add(PjVs[0]); err != nil {
+			audit.Fail(2, Ps[j], err)
+		}
+	}
+
+	// round 3: Paillier proofs
+	for j, r3msg := range r3msgs {
+		if r3msg == nil || r1msgs[j] == nil || ecdsaPub == nil {
+			continue
+		}
+		ok, err := r3msg.UnmarshalProofInts().Verify(r1msgs[j].UnmarshalPaillierPK().N, Ps[j].KeyInt(), ecdsaPub)
+		if err != nil || !ok {
+			audit.Fail(3, Ps[j], errors.New("paillier verify failed"))
+		}
+	}
+	return audit.Err()
+}
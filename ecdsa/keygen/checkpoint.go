@@ -0,0 +1,191 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	cmt "github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/crypto/sealed"
+	"github.com/bnb-chain/tss-lib/v2/crypto/vss"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	// CheckpointContentType identifies sealed containers holding checkpoints of ECDSA keygen
+	CheckpointContentType = "ecdsa-keygen-checkpoint"
+)
+
+type (
+	checkpoint struct {
+		Party    []byte
+		Messages [][][]byte
+		Temp     checkpointTemp
+		Data     LocalPartySaveData
+	}
+
+	checkpointTemp struct {
+		Ui            *big.Int
+		KGCs          []cmt.HashCommitment
+		Vs            vss.Vs
+		Shares        vss.Shares
+		DeCommitPolyG cmt.HashDeCommitment
+		Importer      []byte
+		ImportSecret  *big.Int
+		SSID          []byte
+		SSIDNonce     *big.Int
+	}
+)
+
+// EnableCheckpoints makes the party seal its state with `kek` and pass it to `store` once it has started each round
+// after the first, as described in tss.CheckpointFunc. The epoch in `epochs` is raised before each checkpoint, and the
+// messages of a round are not sent if that or `store` fails. Call it before Start.
+func (p *LocalParty) EnableCheckpoints(
+	kek sealed.KeyEncryptionKey,
+	epochs tss.CheckpointEpochs,
+	store func(checkpoint []byte) error,
+) {
+	tss.SetCheckpointFunc(p, p.params.Parties().IDs(), epochs, func(step int, state []byte) error {
+		bz, err := p.marshalCheckpoint(state)
+		if err != nil {
+			return err
+		}
+		curve, _ := tss.GetCurveName(p.params.EC())
+		md := sealed.Metadata{
+			ContentType: CheckpointContentType,
+			Curve:       curve,
+			Epoch:       uint64(step),
+		}
+		container, err := sealed.Seal(bz, md, kek)
+		if err != nil {
+			return err
+		}
+		return store(container)
+	})
+}
+
+// ResumeLocalParty rebuilds a party from the latest checkpoint it saved with EnableCheckpoints and the `epochs` given
+// to it, using the arguments that the party was created with. This includes an import party, whose secret is restored
+// from the checkpoint. Start fails unless the checkpoint is still the latest one and has not been resumed from before.
+// Start the party to continue the session.
+func ResumeLocalParty(
+	container []byte,
+	kek sealed.KeyEncryptionKey,
+	epochs tss.CheckpointEpochs,
+	params *tss.Parameters,
+	out chan<- tss.Message,
+	end chan<- *LocalPartySaveData,
+) (tss.Party, error) {
+	bz, md, err := sealed.Open(container, kek)
+	if err != nil {
+		return nil, err
+	}
+	if md.ContentType != CheckpointContentType {
+		return nil, fmt.Errorf("the container holds %q, not %q", md.ContentType, CheckpointContentType)
+	}
+	if curve, _ := tss.GetCurveName(params.EC()); md.Curve != curve {
+		return nil, errors.New("the checkpoint was taken on another curve")
+	}
+	cp := new(checkpoint)
+	if err = json.Unmarshal(bz, cp); err != nil {
+		return nil, err
+	}
+	p := NewLocalParty(params, out, end).(*LocalParty)
+	if err = p.unmarshalCheckpoint(cp); err != nil {
+		return nil, err
+	}
+	if err = tss.ResumeParty(p, params.Parties().IDs(), epochs, cp.Party); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ----- //
+
+// CheckpointState is inherited by other rounds
+func (round *base) CheckpointState() (int, [][]bool) {
+	return round.number, [][]bool{round.ok}
+}
+
+// RestoreState is inherited by other rounds
+func (round *base) RestoreState(number int, ok [][]bool) error {
+	if len(ok) != 1 || len(ok[0]) != len(round.ok) {
+		return errors.New("the checkpoint has a round state of an unexpected size")
+	}
+	copy(round.ok, ok[0])
+	round.number, round.started = number, true
+	return nil
+}
+
+func (s *localMessageStore) slots() []*[]tss.ParsedMessage {
+	return []*[]tss.ParsedMessage{&s.kgRound1Messages, &s.kgRound2Message1s, &s.kgRound2Message2s, &s.kgRound3Messages}
+}
+
+func (p *LocalParty) marshalCheckpoint(state []byte) ([]byte, error) {
+	cp := &checkpoint{
+		Party: state,
+		Temp: checkpointTemp{
+			Ui:            p.temp.ui,
+			KGCs:          p.temp.KGCs,
+			Vs:            p.temp.vs,
+			Shares:        p.temp.shares,
+			DeCommitPolyG: p.temp.deCommitPolyG,
+			ImportSecret:  p.temp.importSecret,
+			SSID:          p.temp.ssid,
+			SSIDNonce:     p.temp.ssidNonce,
+		},
+		Data: p.data,
+	}
+	if p.temp.importer != nil {
+		cp.Temp.Importer = p.temp.importer.GetKey()
+	}
+	for _, slot := range p.temp.slots() {
+		bzs, err := tss.MarshalMessages(*slot)
+		if err != nil {
+			return nil, err
+		}
+		cp.Messages = append(cp.Messages, bzs)
+	}
+	return json.Marshal(cp)
+}
+
+func (p *LocalParty) unmarshalCheckpoint(cp *checkpoint) error {
+	slots := p.temp.slots()
+	if len(cp.Messages) != len(slots) {
+		return errors.New("the checkpoint has an unexpected number of message stores")
+	}
+	for i, slot := range slots {
+		msgs, err := tss.UnmarshalMessages(cp.Messages[i], p.params.Parties().IDs())
+		if err != nil {
+			return err
+		}
+		if len(msgs) != len(*slot) {
+			return errors.New("the checkpoint has a message store of an unexpected size")
+		}
+		*slot = msgs
+	}
+	t := cp.Temp
+	p.temp.ui, p.temp.KGCs, p.temp.vs, p.temp.shares, p.temp.deCommitPolyG = t.Ui, t.KGCs, t.Vs, t.Shares, t.DeCommitPolyG
+	if t.Importer != nil {
+		for _, Pj := range p.params.Parties().IDs() {
+			if bytes.Equal(Pj.GetKey(), t.Importer) {
+				p.temp.importer = Pj
+			}
+		}
+		if p.temp.importer == nil {
+			return errors.New("the importer of the checkpoint is not one of the parties")
+		}
+	}
+	p.temp.importSecret = t.ImportSecret
+	p.temp.ssid, p.temp.ssidNonce = t.SSID, t.SSIDNonce
+	p.data = cp.Data
+	return nil
+}
@@ -7,6 +7,7 @@
 package keygen
 
 import (
+	"context"
 	"errors"
 	"math/big"
 
@@ -14,6 +15,7 @@ import (
 )
 
 type DlnProofVerifier struct {
+	ctx       context.Context
 	semaphore chan interface{}
 }
 
@@ -23,6 +25,12 @@ type message interface {
 }
 
 func NewDlnProofVerifier(concurrency int) *DlnProofVerifier {
+	return NewDlnProofVerifierWithContext(context.Background(), concurrency)
+}
+
+// NewDlnProofVerifierWithContext returns a verifier which stops verifying once `ctx` is done.
+// Proofs that are not verified by then are reported as invalid; check ctx.Err() before naming culprits.
+func NewDlnProofVerifierWithContext(ctx context.Context, concurrency int) *DlnProofVerifier {
 	if concurrency == 0 {
 		panic(errors.New("NewDlnProofverifier: concurrency level must not be zero"))
 	}
@@ -30,16 +38,34 @@ func NewDlnProofVerifier(concurrency int) *DlnProofVerifier {
 	semaphore := make(chan interface{}, concurrency)
 
 	return &DlnProofVerifier{
+		ctx:       ctx,
 		semaphore: semaphore,
 	}
 }
 
+// acquire waits for a free worker, returning false if the context is done first
+func (dpv *DlnProofVerifier) acquire() bool {
+	select {
+	case dpv.semaphore <- struct{}{}:
+		if dpv.ctx.Err() != nil {
+			<-dpv.semaphore
+			return false
+		}
+		return true
+	case <-dpv.ctx.Done():
+		return false
+	}
+}
+
 func (dpv *DlnProofVerifier) VerifyDLNProof1(
 	m message,
 	h1, h2, n *big.Int,
 	onDone func(bool),
 ) {
-	dpv.semaphore <- struct{}{}
+	if !dpv.acquire() {
+		onDone(false)
+		return
+	}
 	go func() {
 		defer func() { <-dpv.semaphore }()
 
@@ -58,7 +84,10 @@ func (dpv *DlnProofVerifier) VerifyDLNProof2(
 	h1, h2, n *big.Int,
 	onDone func(bool),
 ) {
-	dpv.semaphore <- struct{}{}
+	if !dpv.acquire() {
+		onDone(false)
+		return
+	}
 	go func() {
 		defer func() { <-dpv.semaphore }()
 
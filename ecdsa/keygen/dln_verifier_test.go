@@ -7,6 +7,7 @@
 package keygen
 
 import (
+	"context"
 	"math/big"
 	"runtime"
 	"testing"
@@ -93,6 +94,28 @@ func TestVerifyDLNProof1_Success(t *testing.T) {
 	}
 }
 
+func TestVerifyDLNProof1_Cancelled(t *testing.T) {
+	preParams, proof := prepareProofT(t)
+	message := &KGRound1Message{
+		Dlnproof_1: proof,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	verifier := NewDlnProofVerifierWithContext(ctx, runtime.GOMAXPROCS(0))
+
+	resultChan := make(chan bool, 1)
+
+	verifier.VerifyDLNProof1(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+		resultChan <- result
+	})
+
+	success := <-resultChan
+	if success {
+		t.Fatal("expected no verification once the context is done")
+	}
+}
+
 func TestVerifyDLNProof1_MalformedMessage(t *testing.T) {
 	preParams, proof := prepareProofT(t)
 	message := &KGRound1Message{
@@ -0,0 +1,567 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.20.3
+// source: protob/ecdsa-keygen-save-data.proto
+
+package keygen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The versioned encoding of a party's ECDSA key data, along with the parameters of the key.
+type SaveDataFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version    uint32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Curve      string                  `protobuf:"bytes,2,opt,name=curve,proto3" json:"curve,omitempty"`
+	Threshold  uint32                  `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Epoch      uint64                  `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Parties    []*SaveDataFile_PartyID `protobuf:"bytes,5,rep,name=parties,proto3" json:"parties,omitempty"`
+	PartyIndex uint32                  `protobuf:"varint,6,opt,name=party_index,json=partyIndex,proto3" json:"party_index,omitempty"`
+	// local secrets
+	Xi      []byte `protobuf:"bytes,7,opt,name=xi,proto3" json:"xi,omitempty"`
+	ShareId []byte `protobuf:"bytes,8,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	// local pre-params
+	PaillierN       []byte `protobuf:"bytes,9,opt,name=paillier_n,json=paillierN,proto3" json:"paillier_n,omitempty"`
+	PaillierLambdaN []byte `protobuf:"bytes,10,opt,name=paillier_lambda_n,json=paillierLambdaN,proto3" json:"paillier_lambda_n,omitempty"`
+	PaillierPhiN    []byte `protobuf:"bytes,11,opt,name=paillier_phi_n,json=paillierPhiN,proto3" json:"paillier_phi_n,omitempty"`
+	PaillierP       []byte `protobuf:"bytes,12,opt,name=paillier_p,json=paillierP,proto3" json:"paillier_p,omitempty"`
+	PaillierQ       []byte `protobuf:"bytes,13,opt,name=paillier_q,json=paillierQ,proto3" json:"paillier_q,omitempty"`
+	NTildeI         []byte `protobuf:"bytes,14,opt,name=n_tilde_i,json=nTildeI,proto3" json:"n_tilde_i,omitempty"`
+	H1I             []byte `protobuf:"bytes,15,opt,name=h1_i,json=h1I,proto3" json:"h1_i,omitempty"`
+	H2I             []byte `protobuf:"bytes,16,opt,name=h2_i,json=h2I,proto3" json:"h2_i,omitempty"`
+	Alpha           []byte `protobuf:"bytes,17,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	Beta            []byte `protobuf:"bytes,18,opt,name=beta,proto3" json:"beta,omitempty"`
+	P               []byte `protobuf:"bytes,19,opt,name=p,proto3" json:"p,omitempty"`
+	Q               []byte `protobuf:"bytes,20,opt,name=q,proto3" json:"q,omitempty"`
+	// public data of every party, in party order
+	Ks          [][]byte                `protobuf:"bytes,21,rep,name=ks,proto3" json:"ks,omitempty"`
+	NTildeJ     [][]byte                `protobuf:"bytes,22,rep,name=n_tilde_j,json=nTildeJ,proto3" json:"n_tilde_j,omitempty"`
+	H1J         [][]byte                `protobuf:"bytes,23,rep,name=h1_j,json=h1J,proto3" json:"h1_j,omitempty"`
+	H2J         [][]byte                `protobuf:"bytes,24,rep,name=h2_j,json=h2J,proto3" json:"h2_j,omitempty"`
+	PaillierPks [][]byte                `protobuf:"bytes,25,rep,name=paillier_pks,json=paillierPks,proto3" json:"paillier_pks,omitempty"`
+	BigXj       []*SaveDataFile_ECPoint `protobuf:"bytes,26,rep,name=big_xj,json=bigXj,proto3" json:"big_xj,omitempty"`
+	EcdsaPub    *SaveDataFile_ECPoint   `protobuf:"bytes,27,opt,name=ecdsa_pub,json=ecdsaPub,proto3" json:"ecdsa_pub,omitempty"`
+}
+
+func (x *SaveDataFile) Reset() {
+	*x = SaveDataFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_keygen_save_data_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveDataFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDataFile) ProtoMessage() {}
+
+func (x *SaveDataFile) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_keygen_save_data_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDataFile.ProtoReflect.Descriptor instead.
+func (*SaveDataFile) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_keygen_save_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SaveDataFile) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SaveDataFile) GetCurve() string {
+	if x != nil {
+		return x.Curve
+	}
+	return ""
+}
+
+func (x *SaveDataFile) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *SaveDataFile) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *SaveDataFile) GetParties() []*SaveDataFile_PartyID {
+	if x != nil {
+		return x.Parties
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetPartyIndex() uint32 {
+	if x != nil {
+		return x.PartyIndex
+	}
+	return 0
+}
+
+func (x *SaveDataFile) GetXi() []byte {
+	if x != nil {
+		return x.Xi
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetShareId() []byte {
+	if x != nil {
+		return x.ShareId
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetPaillierN() []byte {
+	if x != nil {
+		return x.PaillierN
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetPaillierLambdaN() []byte {
+	if x != nil {
+		return x.PaillierLambdaN
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetPaillierPhiN() []byte {
+	if x != nil {
+		return x.PaillierPhiN
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetPaillierP() []byte {
+	if x != nil {
+		return x.PaillierP
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetPaillierQ() []byte {
+	if x != nil {
+		return x.PaillierQ
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetNTildeI() []byte {
+	if x != nil {
+		return x.NTildeI
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetH1I() []byte {
+	if x != nil {
+		return x.H1I
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetH2I() []byte {
+	if x != nil {
+		return x.H2I
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetAlpha() []byte {
+	if x != nil {
+		return x.Alpha
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetBeta() []byte {
+	if x != nil {
+		return x.Beta
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetP() []byte {
+	if x != nil {
+		return x.P
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetQ() []byte {
+	if x != nil {
+		return x.Q
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetKs() [][]byte {
+	if x != nil {
+		return x.Ks
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetNTildeJ() [][]byte {
+	if x != nil {
+		return x.NTildeJ
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetH1J() [][]byte {
+	if x != nil {
+		return x.H1J
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetH2J() [][]byte {
+	if x != nil {
+		return x.H2J
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetPaillierPks() [][]byte {
+	if x != nil {
+		return x.PaillierPks
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetBigXj() []*SaveDataFile_ECPoint {
+	if x != nil {
+		return x.BigXj
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetEcdsaPub() *SaveDataFile_ECPoint {
+	if x != nil {
+		return x.EcdsaPub
+	}
+	return nil
+}
+
+type SaveDataFile_PartyID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Moniker string `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Key     []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *SaveDataFile_PartyID) Reset() {
+	*x = SaveDataFile_PartyID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_keygen_save_data_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveDataFile_PartyID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDataFile_PartyID) ProtoMessage() {}
+
+func (x *SaveDataFile_PartyID) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_keygen_save_data_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDataFile_PartyID.ProtoReflect.Descriptor instead.
+func (*SaveDataFile_PartyID) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_keygen_save_data_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *SaveDataFile_PartyID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SaveDataFile_PartyID) GetMoniker() string {
+	if x != nil {
+		return x.Moniker
+	}
+	return ""
+}
+
+func (x *SaveDataFile_PartyID) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type SaveDataFile_ECPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X []byte `protobuf:"bytes,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y []byte `protobuf:"bytes,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *SaveDataFile_ECPoint) Reset() {
+	*x = SaveDataFile_ECPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_keygen_save_data_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveDataFile_ECPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDataFile_ECPoint) ProtoMessage() {}
+
+func (x *SaveDataFile_ECPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_keygen_save_data_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDataFile_ECPoint.ProtoReflect.Descriptor instead.
+func (*SaveDataFile_ECPoint) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_keygen_save_data_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *SaveDataFile_ECPoint) GetX() []byte {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *SaveDataFile_ECPoint) GetY() []byte {
+	if x != nil {
+		return x.Y
+	}
+	return nil
+}
+
+var File_protob_ecdsa_keygen_save_data_proto protoreflect.FileDescriptor
+
+var file_protob_ecdsa_keygen_save_data_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2f, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2d, 0x6b,
+	0x65, 0x79, 0x67, 0x65, 0x6e, 0x2d, 0x73, 0x61, 0x76, 0x65, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74,
+	0x73, 0x73, 0x6c, 0x69, 0x62, 0x2e, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2e, 0x6b, 0x65, 0x79, 0x67,
+	0x65, 0x6e, 0x22, 0xbf, 0x07, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x75,
+	0x72, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x4b, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x74, 0x73, 0x73, 0x6c, 0x69, 0x62, 0x2e, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2e,
+	0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x46,
+	0x69, 0x6c, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x44, 0x52, 0x07, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x02, 0x78, 0x69, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x4e, 0x12,
+	0x2a, 0x0a, 0x11, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x6d, 0x62,
+	0x64, 0x61, 0x5f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x61, 0x69, 0x6c,
+	0x6c, 0x69, 0x65, 0x72, 0x4c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x4e, 0x12, 0x24, 0x0a, 0x0e, 0x70,
+	0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x68, 0x69, 0x5f, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x68, 0x69,
+	0x4e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x50,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x71, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x51, 0x12,
+	0x1a, 0x0a, 0x09, 0x6e, 0x5f, 0x74, 0x69, 0x6c, 0x64, 0x65, 0x5f, 0x69, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x6e, 0x54, 0x69, 0x6c, 0x64, 0x65, 0x49, 0x12, 0x11, 0x0a, 0x04, 0x68,
+	0x31, 0x5f, 0x69, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x68, 0x31, 0x49, 0x12, 0x11,
+	0x0a, 0x04, 0x68, 0x32, 0x5f, 0x69, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x68, 0x32,
+	0x49, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x65, 0x74, 0x61, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x65, 0x74, 0x61, 0x12, 0x0c, 0x0a, 0x01, 0x70,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x6b, 0x73, 0x18, 0x15, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x02, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x09, 0x6e, 0x5f, 0x74, 0x69, 0x6c,
+	0x64, 0x65, 0x5f, 0x6a, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x6e, 0x54, 0x69, 0x6c,
+	0x64, 0x65, 0x4a, 0x12, 0x11, 0x0a, 0x04, 0x68, 0x31, 0x5f, 0x6a, 0x18, 0x17, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x03, 0x68, 0x31, 0x4a, 0x12, 0x11, 0x0a, 0x04, 0x68, 0x32, 0x5f, 0x6a, 0x18, 0x18,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x68, 0x32, 0x4a, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x69,
+	0x6c, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x6b, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0b, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x6b, 0x73, 0x12, 0x48, 0x0a, 0x06,
+	0x62, 0x69, 0x67, 0x5f, 0x78, 0x6a, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73, 0x73, 0x6c, 0x69, 0x62, 0x2e, 0x65, 0x63,
+	0x64, 0x73, 0x61, 0x2e, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x43, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x05, 0x62, 0x69, 0x67, 0x58, 0x6a, 0x12, 0x4e, 0x0a, 0x09, 0x65, 0x63, 0x64, 0x73, 0x61, 0x5f,
+	0x70, 0x75, 0x62, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73, 0x73, 0x6c, 0x69, 0x62, 0x2e, 0x65, 0x63, 0x64, 0x73, 0x61,
+	0x2e, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x46, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x43, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x63,
+	0x64, 0x73, 0x61, 0x50, 0x75, 0x62, 0x1a, 0x45, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49,
+	0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x25, 0x0a,
+	0x07, 0x45, 0x43, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x01, 0x79, 0x42, 0x0e, 0x5a, 0x0c, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2f, 0x6b, 0x65,
+	0x79, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protob_ecdsa_keygen_save_data_proto_rawDescOnce sync.Once
+	file_protob_ecdsa_keygen_save_data_proto_rawDescData = file_protob_ecdsa_keygen_save_data_proto_rawDesc
+)
+
+func file_protob_ecdsa_keygen_save_data_proto_rawDescGZIP() []byte {
+	file_protob_ecdsa_keygen_save_data_proto_rawDescOnce.Do(func() {
+		file_protob_ecdsa_keygen_save_data_proto_rawDescData = protoimpl.X.CompressGZIP(file_protob_ecdsa_keygen_save_data_proto_rawDescData)
+	})
+	return file_protob_ecdsa_keygen_save_data_proto_rawDescData
+}
+
+var file_protob_ecdsa_keygen_save_data_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_protob_ecdsa_keygen_save_data_proto_goTypes = []interface{}{
+	(*SaveDataFile)(nil),         // 0: binance.tsslib.ecdsa.keygen.SaveDataFile
+	(*SaveDataFile_PartyID)(nil), // 1: binance.tsslib.ecdsa.keygen.SaveDataFile.PartyID
+	(*SaveDataFile_ECPoint)(nil), // 2: binance.tsslib.ecdsa.keygen.SaveDataFile.ECPoint
+}
+var file_protob_ecdsa_keygen_save_data_proto_depIdxs = []int32{
+	1, // 0: binance.tsslib.ecdsa.keygen.SaveDataFile.parties:type_name -> binance.tsslib.ecdsa.keygen.SaveDataFile.PartyID
+	2, // 1: binance.tsslib.ecdsa.keygen.SaveDataFile.big_xj:type_name -> binance.tsslib.ecdsa.keygen.SaveDataFile.ECPoint
+	2, // 2: binance.tsslib.ecdsa.keygen.SaveDataFile.ecdsa_pub:type_name -> binance.tsslib.ecdsa.keygen.SaveDataFile.ECPoint
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_protob_ecdsa_keygen_save_data_proto_init() }
+func file_protob_ecdsa_keygen_save_data_proto_init() {
+	if File_protob_ecdsa_keygen_save_data_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protob_ecdsa_keygen_save_data_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveDataFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_keygen_save_data_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveDataFile_PartyID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_keygen_save_data_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveDataFile_ECPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protob_ecdsa_keygen_save_data_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protob_ecdsa_keygen_save_data_proto_goTypes,
+		DependencyIndexes: file_protob_ecdsa_keygen_save_data_proto_depIdxs,
+		MessageInfos:      file_protob_ecdsa_keygen_save_data_proto_msgTypes,
+	}.Build()
+	File_protob_ecdsa_keygen_save_data_proto = out.File
+	file_protob_ecdsa_keygen_save_data_proto_rawDesc = nil
+	file_protob_ecdsa_keygen_save_data_proto_goTypes = nil
+	file_protob_ecdsa_keygen_save_data_proto_depIdxs = nil
+}
@@ -0,0 +1,117 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/vss"
+	"github.com/bnb-chain/tss-lib/v2/test"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func assertImported(t *testing.T, secret *big.Int, keys []LocalPartySaveData) {
+	ec := tss.S256()
+	pub := crypto.ScalarBaseMult(ec, secret)
+	shares := make(vss.Shares, 0, len(keys))
+	for i, key := range keys {
+		assert.True(t, pub.Equals(key.ECDSAPub), "the public key must be that of the imported secret")
+		assert.True(t, crypto.ScalarBaseMult(ec, key.Xi).Equals(key.BigXj[i]))
+		assert.True(t, key.ValidateWithProof())
+		for j := range keys {
+			assert.True(t, key.BigXj[j].Equals(keys[0].BigXj[j]), "all parties must agree on the public shares")
+			assert.Equal(t, 0, key.NTildej[j].Cmp(keys[j].NTildei))
+			assert.Equal(t, 0, key.PaillierPKs[j].N.Cmp(keys[j].PaillierSK.N))
+		}
+		shares = append(shares, &vss.Share{Threshold: testThreshold, ID: key.ShareID, Share: key.Xi})
+	}
+	recovered, err := shares[len(shares)-testThreshold-1:].ReConstruct(ec)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, secret.Cmp(recovered), "the shares must reconstruct the imported secret")
+}
+
+func TestDealerImport(t *testing.T) {
+	setUp("info")
+
+	fixtures, pIDs, err := LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	preParams := make([]LocalPreParams, len(fixtures))
+	for i, fixture := range fixtures {
+		preParams[i] = fixture.LocalPreParams
+	}
+
+	secret := common.GetRandomPositiveInt(tss.S256().Params().N)
+	keys, err := DealerImport(tss.S256(), secret, pIDs, testThreshold, preParams...)
+	assert.NoError(t, err)
+	assertImported(t, secret, keys)
+
+	_, err = DealerImport(tss.S256(), secret, pIDs, testThreshold, preParams[:1]...)
+	assert.Error(t, err, "pre-params are needed for every party")
+	_, err = DealerImport(tss.S256(), secret, pIDs, testParticipants, preParams...)
+	assert.Error(t, err, "the threshold must be below the number of parties")
+}
+
+func TestE2EConcurrentImport(t *testing.T) {
+	setUp("info")
+
+	fixtures, pIDs, err := LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(pIDs)
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan *LocalPartySaveData, len(pIDs))
+
+	secret := common.GetRandomPositiveInt(tss.S256().Params().N)
+	importer := pIDs[1]
+	parties := make([]*LocalParty, 0, len(pIDs))
+	for i := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+		var s *big.Int
+		if i == importer.Index {
+			s = secret
+		}
+		P := NewImportLocalParty(params, importer, s, outCh, endCh, fixtures[i].LocalPreParams).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	keys := make([]LocalPartySaveData, len(pIDs))
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go test.SharedPartyUpdater(P, msg, errCh)
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case save := <-endCh:
+			index, err := save.OriginalIndex()
+			assert.NoErrorf(t, err, "should not be an error getting a party's index from save data")
+			keys[index] = *save
+			ended++
+		}
+	}
+	assertImported(t, secret, keys)
+}
@@ -29,10 +29,6 @@ type (
 
 		temp localTempData
 		data LocalPartySaveData
-
-		// outbound messaging
-		out chan<- tss.Message
-		end chan<- *LocalPartySaveData
 	}
 
 	localMessageStore struct {
@@ -53,6 +49,10 @@ type (
 		ssidNonce     *big.Int
 		shares        vss.Shares
 		deCommitPolyG cmt.HashDeCommitment
+
+		// key import (nil importer for a fresh key)
+		importer     *tss.PartyID
+		importSecret *big.Int
 	}
 )
 
@@ -76,12 +76,10 @@ func NewLocalParty(
 		data.LocalPreParams = optionalPreParams[0]
 	}
 	p := &LocalParty{
-		BaseParty: new(tss.BaseParty),
+		BaseParty: tss.NewBaseParty(params, out, func(result interface{}) { end <- result.(*LocalPartySaveData) }),
 		params:    params,
 		temp:      localTempData{},
 		data:      data,
-		out:       out,
-		end:       end,
 	}
 	// msgs init
 	p.temp.kgRound1Messages = make([]tss.ParsedMessage, partyCount)
@@ -94,7 +92,7 @@ func NewLocalParty(
 }
 
 func (p *LocalParty) FirstRound() tss.Round {
-	return newRound1(p.params, &p.data, &p.temp, p.out, p.end)
+	return newRound1(p.params, &p.data, &p.temp, p.Outbox())
 }
 
 func (p *LocalParty) Start() *tss.Error {
@@ -102,11 +100,15 @@ func (p *LocalParty) Start() *tss.Error {
 }
 
 func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
-	return tss.BaseUpdate(p, msg, TaskName)
+	opened, openErr := p.params.OpenMessage(msg)
+	if openErr != nil {
+		return false, p.WrapError(openErr)
+	}
+	return tss.BaseUpdate(p, opened, TaskName)
 }
 
 func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
-	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	msg, err := tss.ParseSessionWireMessage(wireBytes, from, isBroadcast, p.params.SessionID())
 	if err != nil {
 		return false, p.WrapError(err)
 	}
@@ -122,6 +124,9 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
 			p.params.PartyCount(), msg.GetFrom().Index), msg.GetFrom())
 	}
+	if err := p.params.ValidateSessionID(msg); err != nil {
+		return false, p.WrapError(err, msg.GetFrom())
+	}
 	return true, nil
 }
 
@@ -133,7 +138,7 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	fromPIdx := msg.GetFrom().Index
 
 	// switch/case is necessary to store any messages beyond current round
-	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	// replays and equivocations are caught by tss.BaseUpdate before this point. we expect the caller to apply spoofing protection.
 	switch msg.Content().(type) {
 	case *KGRound1Message:
 		p.temp.kgRound1Messages[fromPIdx] = msg
@@ -144,7 +149,7 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	case *KGRound3Message:
 		p.temp.kgRound3Messages[fromPIdx] = msg
 	default: // unrecognised message, just ignore!
-		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		p.params.Logger(TaskName, 0).Warn("unrecognised message ignored", common.StringField("msg", msg.String()))
 		return false, nil
 	}
 	return true, nil
@@ -338,6 +338,78 @@ keygen:
 	}
 }
 
+func TestE2EStep(t *testing.T) {
+	setUp("info")
+
+	fixtures, pIDs, err := LoadKeygenTestFixtures(testParticipants)
+	if err != nil {
+		common.Logger.Info("No test fixtures were found, so the safe primes will be generated from scratch. This may take a while...")
+		pIDs = tss.GenerateTestPartyIDs(testParticipants)
+	}
+
+	// the parties are stepped in turn on one goroutine, with no channels to block on
+	p2pCtx := tss.NewPeerContext(pIDs)
+	steppers := make([]*tss.StepParty, len(pIDs))
+	inboxes := make([][]tss.ParsedMessage, len(pIDs))
+	for i := range pIDs {
+		var P tss.Party
+		params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+		// do not use in untrusted setting
+		params.SetNoProofMod()
+		// do not use in untrusted setting
+		params.SetNoProofFac()
+		if i < len(fixtures) {
+			P = NewLocalParty(params, nil, nil, fixtures[i].LocalPreParams)
+		} else {
+			P = NewLocalParty(params, nil, nil)
+		}
+		steppers[i], err = tss.NewStepParty(P)
+		assert.NoError(t, err)
+	}
+	deliver := func(out tss.Message) {
+		bz, _, err := out.WireBytes()
+		assert.NoError(t, err)
+		for j, Pj := range pIDs {
+			if j == out.GetFrom().Index || (!out.IsBroadcast() && out.GetTo()[0].Index != j) {
+				continue
+			}
+			parsed, err := tss.ParseSessionWireMessage(bz, out.GetFrom(), out.IsBroadcast(), out.WireMsg().GetSessionId())
+			assert.NoError(t, err)
+			inboxes[Pj.Index] = append(inboxes[Pj.Index], parsed)
+		}
+	}
+
+	saves := make([]*LocalPartySaveData, len(pIDs))
+	// step every party until no messages are left in flight
+	for pending := true; pending; {
+		for i, S := range steppers {
+			incoming := inboxes[i]
+			inboxes[i] = nil
+			outgoing, result, err := S.Step(incoming)
+			if !assert.Nil(t, err) {
+				return
+			}
+			for _, out := range outgoing {
+				deliver(out)
+			}
+			if result != nil {
+				saves[i] = result.(*LocalPartySaveData)
+			}
+		}
+		pending = false
+		for _, inbox := range inboxes {
+			pending = pending || 0 < len(inbox)
+		}
+	}
+	for i, save := range saves {
+		if !assert.NotNil(t, save, "party %d must finish", i) {
+			continue
+		}
+		assert.True(t, saves[0].ECDSAPub.Equals(save.ECDSAPub), "the parties must agree on the public key")
+		assert.Empty(t, steppers[i].Party().WaitingFor())
+	}
+}
+
 func tryWriteTestFixtureFile(t *testing.T, index int, data LocalPartySaveData) {
 	fixtureFileName := makeTestFixtureFilePath(index)
 
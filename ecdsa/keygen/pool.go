@@ -0,0 +1,401 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto/sealed"
+)
+
+// Pre-params pool
+//
+// A PreParamsPool keeps a number of LocalPreParams ready in a directory, so that keygen, refresh and re-sharing do
+// not wait minutes for safe primes. Run generates pre-params in the background until the pool is full, and again
+// after each checkout. Every set is sealed with the KEK of the pool in a file of its own. A checkout renames the
+// file before reading it, which only one caller can do, and removes it afterwards: a set is never handed out
+// twice, even to processes sharing the directory. The names of the files being written or checked out record when
+// that started. Once they are older than an hour, they are taken to be left by a process that died and removed
+// when a pool is opened or Run wakes up.
+
+const (
+	// PreParamsContentType identifies sealed containers holding ECDSA pre-params
+	PreParamsContentType = "ecdsa-keygen-pre-params"
+
+	preParamsFileExt     = ".preparams"
+	preParamsCheckoutExt = ".checkout"
+	preParamsTempExt     = ".tmp"
+
+	// the time waited before generating again after a failure
+	preParamsRetryInterval = 10 * time.Second
+	// the age of a file being written or checked out after which its process is taken to have died
+	preParamsStaleAge = time.Hour
+)
+
+// ErrPreParamsPoolEmpty is returned by Checkout when the pool holds no pre-params
+var ErrPreParamsPoolEmpty = errors.New("the pre-params pool is empty")
+
+type (
+	PreParamsPool struct {
+		dir  string
+		size int
+		kek  sealed.KeyEncryptionKey
+
+		generate    func(ctx context.Context) (*LocalPreParams, error)
+		concurrency int
+
+		mtx sync.Mutex
+		// closed and replaced when pre-params are added to or taken from the pool
+		changed chan struct{}
+		health  PreParamsPoolHealth
+	}
+
+	// PreParamsPoolHealth reports the state of a pool
+	PreParamsPoolHealth struct {
+		// the pre-params ready in the directory, and the number the pool is kept at
+		Available, Target int
+		// whether Run is generating pre-params now
+		Generating bool
+		// counted since the pool was opened
+		Generated, CheckedOut, Failed, Discarded int
+		// the last error of the generator, nil once it has succeeded again
+		LastError error
+		// the time the last pre-params took to generate
+		LastDuration time.Duration
+	}
+)
+
+// NewPreParamsPool opens the pool kept in `dir`, creating the directory if needed, which Run fills up to `size`
+// pre-params sealed with `kek`
+func NewPreParamsPool(dir string, size int, kek sealed.KeyEncryptionKey) (*PreParamsPool, error) {
+	if size < 1 {
+		return nil, errors.New("NewPreParamsPool: the size must be at least 1")
+	}
+	if kek == nil {
+		return nil, errors.New("NewPreParamsPool: a key encryption key is required")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	pool := &PreParamsPool{
+		dir:     dir,
+		size:    size,
+		kek:     kek,
+		changed: make(chan struct{}),
+	}
+	pool.health.Target = size
+	if err := pool.sweep(); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+// SetConcurrency sets the concurrency given to GeneratePreParamsWithContext
+func (pool *PreParamsPool) SetConcurrency(concurrency int) {
+	pool.concurrency = concurrency
+}
+
+// SetGenerator replaces GeneratePreParamsWithContext as the source of the pre-params, e.g. with one reading them
+// from an external service. Call it before Run.
+func (pool *PreParamsPool) SetGenerator(generate func(ctx context.Context) (*LocalPreParams, error)) {
+	pool.generate = generate
+}
+
+// Run generates pre-params whenever the pool holds fewer than its size, until `ctx` is done. Run it in its own
+// goroutine; one Run per directory is enough, though Checkout may be called from several processes.
+func (pool *PreParamsPool) Run(ctx context.Context) {
+	logger := common.LoggerFromContext(ctx)
+	for {
+		pool.mtx.Lock()
+		changed := pool.changed
+		pool.mtx.Unlock()
+
+		err := pool.sweep()
+		var available int
+		if err == nil {
+			available, err = pool.available()
+		}
+		if err == nil && available < pool.size {
+			if err = pool.fill(ctx); err == nil {
+				continue
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		var retry <-chan time.Time
+		if err != nil {
+			logger.Warn("failed to add pre-params to the pool", common.ErrorField(err))
+			retry = time.After(preParamsRetryInterval)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-changed:
+		case <-retry:
+		}
+	}
+}
+
+// Checkout takes pre-params out of the pool, or returns ErrPreParamsPoolEmpty. Pre-params that cannot be opened
+// or fail to validate are discarded.
+func (pool *PreParamsPool) Checkout() (*LocalPreParams, error) {
+	names, err := pool.list(preParamsFileExt)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		path := filepath.Join(pool.dir, name)
+		taken := checkoutPath(path, time.Now())
+		if err := os.Rename(path, taken); err != nil {
+			if os.IsNotExist(err) {
+				continue // checked out by another caller
+			}
+			return nil, err
+		}
+		preParams, err := pool.open(taken)
+		if rmErr := os.Remove(taken); rmErr != nil && err == nil {
+			err = rmErr
+		}
+		pool.mtx.Lock()
+		if err != nil {
+			pool.health.Discarded++
+		} else {
+			pool.health.CheckedOut++
+		}
+		pool.notify()
+		pool.mtx.Unlock()
+		if err != nil {
+			common.DefaultLogger().Warn("discarded pre-params from the pool", common.StringField("file", name), common.ErrorField(err))
+			continue
+		}
+		return preParams, nil
+	}
+	return nil, ErrPreParamsPoolEmpty
+}
+
+// CheckoutWait is Checkout waiting, while the pool is empty, for Run in this process to add pre-params, until `ctx`
+// is done
+func (pool *PreParamsPool) CheckoutWait(ctx context.Context) (*LocalPreParams, error) {
+	for {
+		pool.mtx.Lock()
+		changed := pool.changed
+		pool.mtx.Unlock()
+
+		preParams, err := pool.Checkout()
+		if err != ErrPreParamsPoolEmpty {
+			return preParams, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// Health reports the state of the pool
+func (pool *PreParamsPool) Health() (PreParamsPoolHealth, error) {
+	available, err := pool.available()
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	health := pool.health
+	health.Available = available
+	return health, err
+}
+
+// ----- //
+
+// fill generates one set of pre-params and adds it to the pool
+func (pool *PreParamsPool) fill(ctx context.Context) error {
+	pool.mtx.Lock()
+	pool.health.Generating = true
+	pool.mtx.Unlock()
+
+	start := time.Now()
+	preParams, err := pool.generatePreParams(ctx)
+	if err == nil {
+		if err = preParams.ValidateFull(); err != nil {
+			err = fmt.Errorf("the generated pre-params failed to validate: %v", err)
+		}
+	}
+	if err == nil {
+		err = pool.add(preParams)
+	}
+
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	pool.health.Generating = false
+	if err != nil {
+		if ctx.Err() == nil {
+			pool.health.Failed++
+			pool.health.LastError = err
+		}
+		return err
+	}
+	pool.health.Generated++
+	pool.health.LastError = nil
+	pool.health.LastDuration = time.Since(start)
+	pool.notify()
+	return nil
+}
+
+func (pool *PreParamsPool) generatePreParams(ctx context.Context) (*LocalPreParams, error) {
+	if pool.generate != nil {
+		return pool.generate(ctx)
+	}
+	if 0 < pool.concurrency {
+		return GeneratePreParamsWithContext(ctx, pool.concurrency)
+	}
+	return GeneratePreParamsWithContext(ctx)
+}
+
+// add seals the pre-params into a new file, which appears in the pool complete or not at all
+func (pool *PreParamsPool) add(preParams *LocalPreParams) error {
+	bz, err := json.Marshal(preParams)
+	if err != nil {
+		return err
+	}
+	container, err := sealed.Seal(bz, sealed.Metadata{ContentType: PreParamsContentType}, pool.kek)
+	if err != nil {
+		return err
+	}
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+	// the names sort by creation time, so that the oldest pre-params are checked out first
+	name := fmt.Sprintf("%020d-%s", time.Now().UnixNano(), hex.EncodeToString(suffix))
+	temp := filepath.Join(pool.dir, name+preParamsTempExt)
+	f, err := os.OpenFile(temp, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(container); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(temp, filepath.Join(pool.dir, name+preParamsFileExt))
+	}
+	if err != nil {
+		_ = os.Remove(temp)
+	}
+	return err
+}
+
+func (pool *PreParamsPool) open(path string) (*LocalPreParams, error) {
+	container, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	bz, md, err := sealed.Open(container, pool.kek)
+	if err != nil {
+		return nil, err
+	}
+	if md.ContentType != PreParamsContentType {
+		return nil, fmt.Errorf("the container holds %q, not %q", md.ContentType, PreParamsContentType)
+	}
+	preParams := new(LocalPreParams)
+	if err := json.Unmarshal(bz, preParams); err != nil {
+		return nil, err
+	}
+	if err := preParams.ValidateFull(); err != nil {
+		return nil, fmt.Errorf("the pre-params failed to validate: %v", err)
+	}
+	return preParams, nil
+}
+
+// sweep removes the files left by a process that died while writing or checking out pre-params. A recent file may
+// belong to a process that is still working on it, so it is kept until it is older than preParamsStaleAge.
+func (pool *PreParamsPool) sweep() error {
+	for _, ext := range []string{preParamsTempExt, preParamsCheckoutExt} {
+		names, err := pool.list(ext)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if started, ok := leftoverTime(name, ext); !ok || time.Since(started) < preParamsStaleAge {
+				continue
+			}
+			if err := os.Remove(filepath.Join(pool.dir, name)); err != nil {
+				if os.IsNotExist(err) {
+					continue // removed by another process
+				}
+				return err
+			}
+			if ext == preParamsCheckoutExt {
+				pool.mtx.Lock()
+				pool.health.Discarded++
+				pool.mtx.Unlock()
+			}
+		}
+	}
+	return nil
+}
+
+// checkoutPath returns the path that the pre-params at `path` are renamed to when they are checked out at `at`
+func checkoutPath(path string, at time.Time) string {
+	return fmt.Sprintf("%s.%020d%s", strings.TrimSuffix(path, preParamsFileExt), at.UnixNano(), preParamsCheckoutExt)
+}
+
+// leftoverTime returns the time recorded in the name of a file with the extension `ext`: when it was created for a
+// temporary file, or when it was checked out. Files with other names are not the pool's and are left alone.
+func leftoverTime(name, ext string) (time.Time, bool) {
+	stem := strings.TrimSuffix(name, ext)
+	stamp := strings.SplitN(stem, "-", 2)[0]
+	if ext == preParamsCheckoutExt {
+		stamp = stem[strings.LastIndex(stem, ".")+1:]
+	}
+	nanos, err := strconv.ParseInt(stamp, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, nanos), true
+}
+
+func (pool *PreParamsPool) available() (int, error) {
+	names, err := pool.list(preParamsFileExt)
+	return len(names), err
+}
+
+// list returns the names of the files in the pool with the extension `ext`, oldest first
+func (pool *PreParamsPool) list(ext string) ([]string, error) {
+	entries, err := os.ReadDir(pool.dir)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ext) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// notify wakes up Run and CheckoutWait; called with the lock held
+func (pool *PreParamsPool) notify() {
+	close(pool.changed)
+	pool.changed = make(chan struct{})
+}
@@ -0,0 +1,117 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto/sealed"
+)
+
+func TestPreParamsPool(t *testing.T) {
+	keys, _, err := LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	dir := t.TempDir()
+	kek, err := sealed.NewAESKEK("pool", make([]byte, 32))
+	assert.NoError(t, err)
+	pool, err := NewPreParamsPool(dir, 3, kek)
+	assert.NoError(t, err)
+
+	// the fixtures stand in for the minutes of safe prime generation
+	var mtx sync.Mutex
+	next := 0
+	pool.SetGenerator(func(ctx context.Context) (*LocalPreParams, error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		if next == len(keys) {
+			return nil, errors.New("out of fixtures")
+		}
+		next++
+		return &keys[next-1].LocalPreParams, nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		pool.Run(ctx)
+		close(done)
+	}()
+
+	// checkouts by concurrent sessions never get the same pre-params
+	const sessions = 2
+	got := make(chan *LocalPreParams, sessions)
+	for i := 0; i < sessions; i++ {
+		go func() {
+			waitCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			preParams, err := pool.CheckoutWait(waitCtx)
+			assert.NoError(t, err)
+			got <- preParams
+		}()
+	}
+	seen := make(map[string]bool)
+	for i := 0; i < sessions; i++ {
+		preParams := <-got
+		if assert.NotNil(t, preParams) {
+			assert.True(t, preParams.ValidateWithProof())
+			assert.False(t, seen[preParams.NTildei.String()], "pre-params were handed out twice")
+			seen[preParams.NTildei.String()] = true
+		}
+	}
+
+	// the pool is refilled, then stops at its size
+	assert.Eventually(t, func() bool {
+		health, err := pool.Health()
+		return err == nil && health.Available == 3 && !health.Generating
+	}, 10*time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+	health, err := pool.Health()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, health.Target)
+	assert.Equal(t, sessions+3, health.Generated)
+	assert.Equal(t, sessions, health.CheckedOut)
+	assert.Nil(t, health.LastError)
+
+	// the pre-params persist, sealed, and a checkout left unfinished by a crash is discarded once it is stale, while
+	// a recent one may belong to another process and is kept
+	names, err := filepath.Glob(filepath.Join(dir, "*"+preParamsFileExt))
+	assert.NoError(t, err)
+	var recent string
+	if assert.Len(t, names, 3) {
+		assert.NoError(t, os.Rename(names[0], checkoutPath(names[0], time.Now().Add(-2*preParamsStaleAge))))
+		recent = checkoutPath(names[1], time.Now())
+		assert.NoError(t, os.Rename(names[1], recent))
+	}
+	pool, err = NewPreParamsPool(dir, 3, kek)
+	assert.NoError(t, err)
+	health, err = pool.Health()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, health.Available)
+	assert.Equal(t, 1, health.Discarded)
+	assert.FileExists(t, recent)
+
+	// pre-params sealed with another key are discarded rather than handed out
+	otherKEK, err := sealed.NewAESKEK("other", make([]byte, 32))
+	assert.NoError(t, err)
+	other, err := NewPreParamsPool(dir, 3, otherKEK)
+	assert.NoError(t, err)
+	_, err = other.Checkout()
+	assert.Equal(t, ErrPreParamsPoolEmpty, err)
+	health, err = other.Health()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, health.Available)
+	assert.Equal(t, 1, health.Discarded)
+}
@@ -7,8 +7,11 @@
 package keygen
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/bnb-chain/tss-lib/v2/common"
 	"github.com/bnb-chain/tss-lib/v2/crypto"
@@ -23,9 +26,9 @@ var (
 )
 
 // round 1 represents round 1 of the keygen part of the GG18 ECDSA TSS spec (Gennaro, Goldfeder; 2018)
-func newRound1(params *tss.Parameters, save *LocalPartySaveData, temp *localTempData, out chan<- tss.Message, end chan<- *LocalPartySaveData) tss.Round {
+func newRound1(params *tss.Parameters, save *LocalPartySaveData, temp *localTempData, out *tss.Outbox) tss.Round {
 	return &round1{
-		&base{params, save, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+		&base{params, save, temp, out, make([]bool, len(params.Parties().IDs())), false, 1}}
 }
 
 func (round *round1) Start() *tss.Error {
@@ -40,13 +43,24 @@ func (round *round1) Start() *tss.Error {
 	i := Pi.Index
 
 	// 1. calculate "partial" key share ui
-	ui := common.GetRandomPositiveInt(round.Params().EC().Params().N)
-
-	round.temp.ui = ui
-
-	// 2. compute the vss shares
+	// when importing a key, the importer's ui is the key and the other parties share zero
 	ids := round.Parties().IDs().Keys()
-	vs, shares, err := vss.Create(round.Params().EC(), round.Threshold(), ui, ids)
+	var ui *big.Int
+	var vs vss.Vs
+	var shares vss.Shares
+	var err error
+	if round.vsOffset(i) == 1 {
+		vs, shares, err = vss.CreateZeroSharing(round.Rand(), round.Params().EC(), round.Threshold(), ids)
+	} else {
+		if ui = round.temp.importSecret; ui == nil {
+			ui = common.GetRandomPositiveIntWithRand(round.Rand(), round.Params().EC().Params().N)
+		}
+		round.temp.ui = ui
+
+		// 2. compute the vss shares
+		vs, shares, err = vss.CreateWithRand(round.Rand(), round.Params().EC(), round.Threshold(), ui, ids)
+	}
+	round.temp.importSecret = nil
 	if err != nil {
 		return round.WrapError(err, Pi)
 	}
@@ -61,7 +75,7 @@ func (round *round1) Start() *tss.Error {
 	if err != nil {
 		return round.WrapError(err, Pi)
 	}
-	cmt := cmts.NewHashCommitment(pGFlat...)
+	cmt := cmts.NewHashCommitmentWithRand(round.Rand(), pGFlat...)
 
 	// 4. generate Paillier public key E_i, private key and proof
 	// 5-7. generate safe primes for ZKPs used later on
@@ -72,9 +86,14 @@ func (round *round1) Start() *tss.Error {
 		return round.WrapError(
 			errors.New("`optionalPreParams` failed to validate; it might have been generated with an older version of tss-lib"))
 	} else if round.save.LocalPreParams.ValidateWithProof() {
+		if err := round.save.LocalPreParams.ValidateFull(); err != nil {
+			return round.WrapError(fmt.Errorf("`optionalPreParams` failed to validate: %v", err))
+		}
 		preParams = &round.save.LocalPreParams
 	} else {
-		preParams, err = GeneratePreParams(round.SafePrimeGenTimeout(), round.Concurrency())
+		ctx, cancel := context.WithTimeout(common.ContextWithLogger(round.Context(), round.Logger(TaskName, 1)), round.SafePrimeGenTimeout())
+		defer cancel()
+		preParams, err = GeneratePreParamsWithContext(ctx, round.Concurrency())
 		if err != nil {
 			return round.WrapError(errors.New("pre-params generation failed"), Pi)
 		}
@@ -92,15 +111,19 @@ func (round *round1) Start() *tss.Error {
 		preParams.P,
 		preParams.Q,
 		preParams.NTildei
-	dlnProof1 := dlnproof.NewDLNProof(h1i, h2i, alpha, p, q, NTildei)
-	dlnProof2 := dlnproof.NewDLNProof(h2i, h1i, beta, p, q, NTildei)
+	start := time.Now()
+	dlnProof1 := dlnproof.NewDLNProofWithRand(round.Rand(), h1i, h2i, alpha, p, q, NTildei)
+	round.ObserveProofGenerated(tss.ProofDLN, start)
+	start = time.Now()
+	dlnProof2 := dlnproof.NewDLNProofWithRand(round.Rand(), h2i, h1i, beta, p, q, NTildei)
+	round.ObserveProofGenerated(tss.ProofDLN, start)
 
 	// for this P: SAVE
 	// - shareID
 	// and keep in temporary storage:
 	// - VSS Vs
 	// - our set of Shamir shares
-	round.temp.ssidNonce = new(big.Int).SetUint64(0)
+	round.temp.ssidNonce = round.SSIDNonce()
 	round.save.ShareID = ids[i]
 	round.temp.vs = vs
 	ssid, err := round.getSSID()
@@ -123,7 +146,7 @@ func (round *round1) Start() *tss.Error {
 			return round.WrapError(err, Pi)
 		}
 		round.temp.kgRound1Messages[i] = msg
-		round.out <- msg
+		round.out.Send(msg)
 	}
 	return nil
 }
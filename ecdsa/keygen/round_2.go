@@ -11,6 +11,7 @@ import (
 	"errors"
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/bnb-chain/tss-lib/v2/crypto/facproof"
 	"github.com/bnb-chain/tss-lib/v2/crypto/modproof"
@@ -31,12 +32,8 @@ func (round *round2) Start() *tss.Error {
 	round.started = true
 	round.resetOK()
 
-	common.Logger.Debugf(
-		"%s Setting up DLN verification with concurrency level of %d",
-		round.PartyID(),
-		round.Concurrency(),
-	)
-	dlnVerifier := NewDlnProofVerifier(round.Concurrency())
+	round.Logger(TaskName, round.number).Debug("setting up DLN verification", common.IntField("concurrency", round.Concurrency()))
+	dlnVerifier := NewDlnProofVerifierWithContext(round.Context(), round.Concurrency())
 
 	i := round.PartyID().Index
 
@@ -74,13 +71,16 @@ func (round *round2) Start() *tss.Error {
 		_j := j
 		_msg := msg
 
+		start := time.Now()
 		dlnVerifier.VerifyDLNProof1(r1msg, H1j, H2j, NTildej, func(isValid bool) {
+			round.ObserveProofVerified(tss.ProofDLN, isValid, start)
 			if !isValid {
 				dlnProof1FailCulprits[_j] = _msg.GetFrom()
 			}
 			wg.Done()
 		})
 		dlnVerifier.VerifyDLNProof2(r1msg, H2j, H1j, NTildej, func(isValid bool) {
+			round.ObserveProofVerified(tss.ProofDLN, isValid, start)
 			if !isValid {
 				dlnProof2FailCulprits[_j] = _msg.GetFrom()
 			}
@@ -88,6 +88,9 @@ func (round *round2) Start() *tss.Error {
 		})
 	}
 	wg.Wait()
+	if err := round.Context().Err(); err != nil {
+		return round.WrapError(err)
+	}
 	for _, culprit := range append(dlnProof1FailCulprits, dlnProof2FailCulprits...) {
 		if culprit != nil {
 			return round.WrapError(errors.New("dln proof verification failed"), culprit)
@@ -120,11 +123,14 @@ func (round *round2) Start() *tss.Error {
 			Z1: zero, Z2: zero, W1: zero, W2: zero, V: zero}
 		if !round.Params().NoProofFac() {
 			var err error
-			facProof, err = facproof.NewProof(ContextI, round.EC(), round.save.PaillierSK.N, round.save.NTildej[j],
+			start := time.Now()
+			facProof, err = facproof.NewProofWithRand(
+				round.Rand(), ContextI, round.EC(), round.save.PaillierSK.N, round.save.NTildej[j],
 				round.save.H1j[j], round.save.H2j[j], round.save.PaillierSK.P, round.save.PaillierSK.Q)
 			if err != nil {
 				return round.WrapError(err, round.PartyID())
 			}
+			round.ObserveProofGenerated(tss.ProofFac, start)
 
 		}
 		r2msg1 := NewKGRound2Message1(Pj, round.PartyID(), shares[j], facProof)
@@ -133,22 +139,25 @@ func (round *round2) Start() *tss.Error {
 			round.temp.kgRound2Message1s[j] = r2msg1
 			continue
 		}
-		round.out <- r2msg1
+		round.out.Send(r2msg1)
 	}
 
 	// 7. BROADCAST de-commitments of Shamir poly*G
 	modProof := &modproof.ProofMod{W: zero, X: *new([80]*big.Int), A: zero, B: zero, Z: *new([80]*big.Int)}
 	if !round.Parameters.NoProofMod() {
 		var err error
-		modProof, err = modproof.NewProof(ContextI, round.save.PaillierSK.N,
+		start := time.Now()
+		modProof, err = modproof.NewProofWithRand(
+			round.Rand(), ContextI, round.save.PaillierSK.N,
 			round.save.PaillierSK.P, round.save.PaillierSK.Q)
 		if err != nil {
 			return round.WrapError(err, round.PartyID())
 		}
+		round.ObserveProofGenerated(tss.ProofMod, start)
 	}
 	r2msg2 := NewKGRound2Message2(round.PartyID(), round.temp.deCommitPolyG, modProof)
 	round.temp.kgRound2Message2s[i] = r2msg2
-	round.out <- r2msg2
+	round.out.Send(r2msg2)
 
 	return nil
 }
@@ -9,6 +9,7 @@ package keygen
 import (
 	"errors"
 	"math/big"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	errors2 "github.com/pkg/errors"
@@ -45,8 +46,8 @@ func (round *round3) Start() *tss.Error {
 
 	// 2-3.
 	Vc := make(vss.Vs, round.Threshold()+1)
-	for c := range Vc {
-		Vc[c] = round.temp.vs[c] // ours
+	for c, v := range round.temp.vs {
+		Vc[c+round.vsOffset(PIdx)] = v // ours
 	}
 
 	// 4-11.
@@ -83,17 +84,24 @@ func (round *round3) Start() *tss.Error {
 				ch <- vssOut{err, nil}
 				return
 			}
+			if len(PjVs) != round.Threshold()+1-round.vsOffset(j) {
+				ch <- vssOut{errors.New("unexpected number of vss commitments"), nil}
+				return
+			}
 			modProof, err := r2msg2.UnmarshalModProof()
 			if err != nil && round.Parameters.NoProofMod() {
 				// For old parties, the modProof could be not exist
 				// Not return error for compatibility reason
-				common.Logger.Warningf("modProof not exist:%s", Ps[j])
+				round.Logger(TaskName, round.number).Warn("modProof not exist", common.StringField("peer", Ps[j].String()))
 			} else {
 				if err != nil {
 					ch <- vssOut{errors.New("modProof verify failed"), nil}
 					return
 				}
-				if ok = modProof.Verify(ContextJ, round.save.PaillierPKs[j].N); !ok {
+				start := time.Now()
+				ok = modProof.Verify(ContextJ, round.save.PaillierPKs[j].N)
+				round.ObserveProofVerified(tss.ProofMod, ok, start)
+				if !ok {
 					ch <- vssOut{errors.New("modProof verify failed"), nil}
 					return
 				}
@@ -104,7 +112,12 @@ func (round *round3) Start() *tss.Error {
 				ID:        round.PartyID().KeyInt(),
 				Share:     r2msg1.UnmarshalShare(),
 			}
-			if ok = PjShare.Verify(round.Params().EC(), round.Threshold(), PjVs); !ok {
+			if round.vsOffset(j) == 1 {
+				ok = PjShare.VerifyZeroShare(round.Params().EC(), round.Threshold(), PjVs)
+			} else {
+				ok = PjShare.Verify(round.Params().EC(), round.Threshold(), PjVs)
+			}
+			if !ok {
 				ch <- vssOut{errors.New("vss verify failed"), nil}
 				return
 			}
@@ -112,14 +125,17 @@ func (round *round3) Start() *tss.Error {
 			if err != nil && round.NoProofFac() {
 				// For old parties, the facProof could be not exist
 				// Not return error for compatibility reason
-				common.Logger.Warningf("facProof not exist:%s", Ps[j])
+				round.Logger(TaskName, round.number).Warn("facProof not exist", common.StringField("peer", Ps[j].String()))
 			} else {
 				if err != nil {
 					ch <- vssOut{errors.New("facProof verify failed"), nil}
 					return
 				}
-				if ok = facProof.Verify(ContextJ, round.EC(), round.save.PaillierPKs[j].N, round.save.NTildei,
-					round.save.H1i, round.save.H2i); !ok {
+				start := time.Now()
+				ok = facProof.Verify(ContextJ, round.EC(), round.save.PaillierPKs[j].N, round.save.NTildei,
+					round.save.H1i, round.save.H2i)
+				round.ObserveProofVerified(tss.ProofFac, ok, start)
+				if !ok {
 					ch <- vssOut{errors.New("facProof verify failed"), nil}
 					return
 				}
@@ -164,8 +180,13 @@ func (round *round3) Start() *tss.Error {
 			}
 			// 10-11.
 			PjVs := vssResults[j].pjVs
-			for c := 0; c <= round.Threshold(); c++ {
-				Vc[c], err = Vc[c].Add(PjVs[c])
+			for c, v := range PjVs {
+				c += round.vsOffset(j)
+				if Vc[c] == nil {
+					Vc[c] = v
+					continue
+				}
+				Vc[c], err = Vc[c].Add(v)
 				if err != nil {
 					culprits = append(culprits, Pj)
 				}
@@ -209,15 +230,14 @@ func (round *round3) Start() *tss.Error {
 	}
 	round.save.ECDSAPub = ecdsaPubKey
 
-	// PRINT public key & private share
-	common.Logger.Debugf("%s public key: %x", round.PartyID(), ecdsaPubKey)
-
 	// BROADCAST paillier proof for Pi
 	ki := round.PartyID().KeyInt()
+	start := time.Now()
 	proof := round.save.PaillierSK.Proof(ki, ecdsaPubKey)
+	round.ObserveProofGenerated(tss.ProofPaillier, start)
 	r3msg := NewKGRound3Message(round.PartyID(), proof)
 	round.temp.kgRound3Messages[PIdx] = r3msg
-	round.out <- r3msg
+	round.out.Send(r3msg)
 	return nil
 }
 
@@ -8,6 +8,7 @@ package keygen
 
 import (
 	"errors"
+	"time"
 
 	"github.com/bnb-chain/tss-lib/v2/common"
 	"github.com/bnb-chain/tss-lib/v2/crypto/paillier"
@@ -41,9 +42,11 @@ func (round *round4) Start() *tss.Error {
 		r3msg := msg.Content().(*KGRound3Message)
 		go func(prf paillier.Proof, j int, ch chan<- bool) {
 			ppk := round.save.PaillierPKs[j]
+			start := time.Now()
 			ok, err := prf.Verify(ppk.N, PIDs[j], ecdsaPub)
+			round.ObserveProofVerified(tss.ProofPaillier, ok && err == nil, start)
 			if err != nil {
-				common.Logger.Error(round.WrapError(err, Ps[j]).Error())
+				round.Logger(TaskName, round.number).Error("paillier verify failed", common.StringField("peer", Ps[j].String()), common.ErrorField(err))
 				ch <- false
 				return
 			}
@@ -63,17 +66,17 @@ func (round *round4) Start() *tss.Error {
 	for j, ok := range round.ok {
 		if !ok {
 			culprits = append(culprits, Ps[j])
-			common.Logger.Warningf("paillier verify failed for party %s", Ps[j])
+			round.Logger(TaskName, round.number).Warn("paillier verify failed", common.StringField("peer", Ps[j].String()))
 			continue
 		}
-		common.Logger.Debugf("paillier verify passed for party %s", Ps[j])
+		round.Logger(TaskName, round.number).Debug("paillier verify passed", common.StringField("peer", Ps[j].String()))
 
 	}
 	if len(culprits) > 0 {
 		return round.WrapError(errors.New("paillier verify failed"), culprits...)
 	}
 
-	round.end <- round.save
+	round.out.Finish(round.save)
 
 	return nil
 }
@@ -22,8 +22,7 @@ type (
 		*tss.Parameters
 		save    *LocalPartySaveData
 		temp    *localTempData
-		out     chan<- tss.Message
-		end     chan<- *LocalPartySaveData
+		out     *tss.Outbox
 		ok      []bool // `ok` tracks parties which have been verified by Update()
 		started bool
 		number  int
@@ -7,15 +7,24 @@
 package keygen
 
 import (
+	"crypto/elliptic"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
 
+	"github.com/bnb-chain/tss-lib/v2/common"
 	"github.com/bnb-chain/tss-lib/v2/crypto"
 	"github.com/bnb-chain/tss-lib/v2/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/v2/crypto/vss"
 	"github.com/bnb-chain/tss-lib/v2/tss"
 )
 
+// the Miller-Rabin rounds of the primality tests of ValidateFull, as when the primes are generated
+const primeTestN = 30
+
+var one = big.NewInt(1)
+
 type (
 	LocalPreParams struct {
 		PaillierSK *paillier.PrivateKey // ski
@@ -76,6 +85,150 @@ func (preParams LocalPreParams) ValidateWithProof() bool {
 		preParams.Q != nil
 }
 
+// ValidateFull checks the pre-params themselves rather than only their presence: that P and Q are Sophie Germain
+// primes whose safe primes make up NTildei, that H1i and H2i generate the subgroup of order P·Q of the quadratic
+// residues mod NTildei with H2i = H1i^Alpha and H1i = H2i^Beta, and that the Paillier key is made of its primes.
+// It costs a few primality tests, so call it where the pre-params are loaded rather than each time they are used.
+func (preParams LocalPreParams) ValidateFull() error {
+	if !preParams.ValidateWithProof() {
+		return errors.New("the pre-params are incomplete; they might have been generated with an older version of tss-lib")
+	}
+	if err := validatePaillierSK(preParams.PaillierSK); err != nil {
+		return err
+	}
+
+	p, q := preParams.P, preParams.Q
+	if !p.ProbablyPrime(primeTestN) || !q.ProbablyPrime(primeTestN) {
+		return errors.New("P or Q is not prime")
+	}
+	if p.Cmp(q) == 0 {
+		return errors.New("P and Q are equal")
+	}
+	safeP, safeQ := safePrime(p), safePrime(q)
+	if !safeP.ProbablyPrime(primeTestN) || !safeQ.ProbablyPrime(primeTestN) {
+		return errors.New("2P+1 or 2Q+1 is not prime")
+	}
+	if new(big.Int).Mul(safeP, safeQ).Cmp(preParams.NTildei) != 0 {
+		return errors.New("NTildei is not (2P+1)(2Q+1)")
+	}
+
+	NTildei, h1, h2 := preParams.NTildei, preParams.H1i, preParams.H2i
+	for _, h := range []*big.Int{h1, h2} {
+		if h.Cmp(one) <= 0 || NTildei.Cmp(h) <= 0 || new(big.Int).GCD(nil, nil, h, NTildei).Cmp(one) != 0 {
+			return errors.New("H1i or H2i is not a unit mod NTildei")
+		}
+	}
+	if h1.Cmp(h2) == 0 {
+		return errors.New("H1i and H2i are equal")
+	}
+	// the group of quadratic residues mod NTildei has order P·Q, so h1 generates it when its order is neither P nor Q
+	modNTilde := common.ModInt(NTildei)
+	pq := new(big.Int).Mul(p, q)
+	if modNTilde.Exp(h1, pq).Cmp(one) != 0 || modNTilde.Exp(h1, p).Cmp(one) == 0 || modNTilde.Exp(h1, q).Cmp(one) == 0 {
+		return errors.New("H1i does not generate the subgroup of order PQ")
+	}
+	if common.ModInt(pq).Mul(preParams.Alpha, preParams.Beta).Cmp(one) != 0 {
+		return errors.New("Beta is not the inverse of Alpha mod PQ")
+	}
+	if modNTilde.Exp(h1, preParams.Alpha).Cmp(h2) != 0 || modNTilde.Exp(h2, preParams.Beta).Cmp(h1) != 0 {
+		return errors.New("H2i is not H1i^Alpha, or H1i is not H2i^Beta")
+	}
+	return nil
+}
+
+// ValidateFull checks that the save data is consistent for a key on `ec` shared with `threshold`: the pre-params
+// as in LocalPreParams.ValidateFull, the lengths of the arrays, that Xi·G is this party's BigXj, that the BigXj
+// lie on one polynomial of degree `threshold` whose value at zero is ECDSAPub, and that this party's entries of
+// NTildej, H1j, H2j and PaillierPKs are its own pre-params. Save data of a subset of the parties, as made by
+// BuildLocalSaveDataSubset, validates as well.
+func (data LocalPartySaveData) ValidateFull(ec elliptic.Curve, threshold int) error {
+	if err := data.LocalPreParams.ValidateFull(); err != nil {
+		return err
+	}
+	n := len(data.Ks)
+	if threshold < 1 || n <= threshold {
+		return fmt.Errorf("the save data holds %d parties, which is too few for threshold %d", n, threshold)
+	}
+	if len(data.NTildej) != n || len(data.H1j) != n || len(data.H2j) != n || len(data.BigXj) != n || len(data.PaillierPKs) != n {
+		return errors.New("the arrays of the save data have different lengths")
+	}
+	for j := 0; j < n; j++ {
+		if data.Ks[j] == nil || data.NTildej[j] == nil || data.H1j[j] == nil || data.H2j[j] == nil ||
+			data.PaillierPKs[j] == nil || data.PaillierPKs[j].N == nil {
+			return fmt.Errorf("the save data of party %d is incomplete", j)
+		}
+		if data.BigXj[j] == nil || !data.BigXj[j].ValidateBasic() {
+			return fmt.Errorf("BigXj[%d] is not a point on the curve", j)
+		}
+		if data.H1j[j].Cmp(data.H2j[j]) == 0 {
+			return fmt.Errorf("H1j[%d] and H2j[%d] are equal", j, j)
+		}
+	}
+	if _, err := vss.CheckIndexes(ec, data.Ks); err != nil {
+		return err
+	}
+	if data.ECDSAPub == nil || !data.ECDSAPub.ValidateBasic() {
+		return errors.New("ECDSAPub is not a point on the curve")
+	}
+
+	i := -1
+	for j, kj := range data.Ks {
+		if data.ShareID != nil && kj.Cmp(data.ShareID) == 0 {
+			i = j
+			break
+		}
+	}
+	if i < 0 {
+		return errors.New("the ShareID of the save data is not one of its Ks")
+	}
+	if data.Xi == nil || data.Xi.Sign() <= 0 || ec.Params().N.Cmp(data.Xi) <= 0 {
+		return errors.New("Xi is out of range")
+	}
+	if !crypto.ScalarBaseMult(ec, data.Xi).Equals(data.BigXj[i]) {
+		return errors.New("Xi·G is not this party's BigXj")
+	}
+	if data.NTildej[i].Cmp(data.NTildei) != 0 || data.H1j[i].Cmp(data.H1i) != 0 || data.H2j[i].Cmp(data.H2i) != 0 ||
+		data.PaillierPKs[i].N.Cmp(data.PaillierSK.N) != 0 {
+		return errors.New("this party's public entries do not match its pre-params")
+	}
+
+	// interpolate the shares of the first threshold+1 parties at zero, then at each Ks of the others
+	ids := data.Ks[:threshold+1]
+	interpolate := func(x *big.Int) (*crypto.ECPoint, error) {
+		var sum *crypto.ECPoint
+		for k := range ids {
+			lambda, err := vss.LagrangeCoefficient(ec, ids, k, x)
+			if err != nil {
+				return nil, err
+			}
+			term := data.BigXj[k].ScalarMult(lambda)
+			if sum == nil {
+				sum = term
+			} else if sum, err = sum.Add(term); err != nil {
+				return nil, err
+			}
+		}
+		return sum, nil
+	}
+	pub, err := interpolate(zero)
+	if err != nil {
+		return err
+	}
+	if !pub.Equals(data.ECDSAPub) {
+		return errors.New("the BigXj do not interpolate to ECDSAPub")
+	}
+	for j := threshold + 1; j < n; j++ {
+		Xj, err := interpolate(data.Ks[j])
+		if err != nil {
+			return err
+		}
+		if !Xj.Equals(data.BigXj[j]) {
+			return fmt.Errorf("BigXj[%d] does not lie on the polynomial of the other parties", j)
+		}
+	}
+	return nil
+}
+
 // BuildLocalSaveDataSubset re-creates the LocalPartySaveData to contain data for only the list of signing parties.
 func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.SortedPartyIDs) LocalPartySaveData {
 	keysToIndices := make(map[string]int, len(sourceData.Ks))
@@ -100,3 +253,33 @@ func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.Sorte
 	}
 	return newData
 }
+
+// ----- //
+
+func validatePaillierSK(sk *paillier.PrivateKey) error {
+	P, Q := sk.P, sk.Q
+	if sk.N == nil || sk.PhiN == nil || sk.LambdaN == nil {
+		return errors.New("the Paillier secret key is incomplete")
+	}
+	if !P.ProbablyPrime(primeTestN) || !Q.ProbablyPrime(primeTestN) || P.Cmp(Q) == 0 {
+		return errors.New("the Paillier P and Q are not two distinct primes")
+	}
+	if new(big.Int).Mul(P, Q).Cmp(sk.N) != 0 {
+		return errors.New("the Paillier N is not P·Q")
+	}
+	PMinus1, QMinus1 := new(big.Int).Sub(P, one), new(big.Int).Sub(Q, one)
+	phiN := new(big.Int).Mul(PMinus1, QMinus1)
+	if phiN.Cmp(sk.PhiN) != 0 {
+		return errors.New("the Paillier PhiN is not (P-1)(Q-1)")
+	}
+	gcd := new(big.Int).GCD(nil, nil, PMinus1, QMinus1)
+	if new(big.Int).Div(phiN, gcd).Cmp(sk.LambdaN) != 0 {
+		return errors.New("the Paillier LambdaN is not lcm(P-1, Q-1)")
+	}
+	return nil
+}
+
+// safePrime returns 2p+1
+func safePrime(p *big.Int) *big.Int {
+	return new(big.Int).Add(new(big.Int).Lsh(p, 1), one)
+}
@@ -0,0 +1,309 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	// SaveDataVersion is the version of the SaveDataFile encoding written by SavedKey.Marshal
+	SaveDataVersion = 1
+)
+
+// SavedKey is a party's ECDSA key data together with the parameters of the key, as persisted in a SaveDataFile.
+// The Epoch is chosen by the caller, for example to count the refreshes and re-sharings of the key.
+type SavedKey struct {
+	Version   uint32
+	Curve     tss.CurveName
+	Threshold int
+	Epoch     uint64
+	Parties   tss.SortedPartyIDs
+	PartyID   *tss.PartyID
+	Data      LocalPartySaveData
+}
+
+// NewSavedKey describes the key data output by a keygen, re-sharing or refresh run with `params`.
+// After a re-sharing, `params` should hold the new committee and threshold.
+func NewSavedKey(params *tss.Parameters, data LocalPartySaveData, epoch uint64) (*SavedKey, error) {
+	curve, ok := tss.GetCurveName(params.EC())
+	if !ok {
+		return nil, errors.New("the curve of the parameters is not registered")
+	}
+	parties := params.Parties().IDs()
+	if len(parties) != len(data.Ks) {
+		return nil, fmt.Errorf("expected save data for %d parties, got %d", len(parties), len(data.Ks))
+	}
+	for j, Pj := range parties {
+		if data.Ks[j] == nil || data.Ks[j].Cmp(Pj.KeyInt()) != 0 {
+			return nil, fmt.Errorf("the save data does not match party %s", Pj)
+		}
+	}
+	if data.ShareID != nil && data.ShareID.Cmp(params.PartyID().KeyInt()) != 0 {
+		return nil, errors.New("the save data belongs to another party")
+	}
+	return &SavedKey{
+		Version:   SaveDataVersion,
+		Curve:     curve,
+		Threshold: params.Threshold(),
+		Epoch:     epoch,
+		Parties:   parties,
+		PartyID:   params.PartyID(),
+		Data:      data,
+	}, nil
+}
+
+// Marshal encodes the key as a SaveDataFile
+func (k *SavedKey) Marshal() ([]byte, error) {
+	data := k.Data
+	if k.PartyID == nil || k.PartyID.Index < 0 || len(k.Parties) <= k.PartyID.Index {
+		return nil, errors.New("the party of the saved key is not one of its parties")
+	}
+	if data.PaillierSK == nil || data.ECDSAPub == nil {
+		return nil, errors.New("the save data is incomplete")
+	}
+	bigXj, err := ecPointsToSaveData(data.BigXj)
+	if err != nil {
+		return nil, err
+	}
+	paillierPKs := make([]*big.Int, len(data.PaillierPKs))
+	for j, pk := range data.PaillierPKs {
+		if pk == nil {
+			return nil, errors.New("the save data is incomplete")
+		}
+		paillierPKs[j] = pk.N
+	}
+	file := &SaveDataFile{
+		Version:         SaveDataVersion,
+		Curve:           string(k.Curve),
+		Threshold:       uint32(k.Threshold),
+		Epoch:           k.Epoch,
+		Parties:         partyIDsToSaveData(k.Parties),
+		PartyIndex:      uint32(k.PartyID.Index),
+		Xi:              bytesOrNil(data.Xi),
+		ShareId:         bytesOrNil(data.ShareID),
+		PaillierN:       bytesOrNil(data.PaillierSK.N),
+		PaillierLambdaN: bytesOrNil(data.PaillierSK.LambdaN),
+		PaillierPhiN:    bytesOrNil(data.PaillierSK.PhiN),
+		PaillierP:       bytesOrNil(data.PaillierSK.P),
+		PaillierQ:       bytesOrNil(data.PaillierSK.Q),
+		NTildeI:         bytesOrNil(data.NTildei),
+		H1I:             bytesOrNil(data.H1i),
+		H2I:             bytesOrNil(data.H2i),
+		Alpha:           bytesOrNil(data.Alpha),
+		Beta:            bytesOrNil(data.Beta),
+		P:               bytesOrNil(data.P),
+		Q:               bytesOrNil(data.Q),
+		Ks:              common.BigIntsToBytes(data.Ks),
+		NTildeJ:         common.BigIntsToBytes(data.NTildej),
+		H1J:             common.BigIntsToBytes(data.H1j),
+		H2J:             common.BigIntsToBytes(data.H2j),
+		PaillierPks:     common.BigIntsToBytes(paillierPKs),
+		BigXj:           bigXj,
+		EcdsaPub:        &SaveDataFile_ECPoint{X: data.ECDSAPub.X().Bytes(), Y: data.ECDSAPub.Y().Bytes()},
+	}
+	return proto.Marshal(file)
+}
+
+// UnmarshalSavedKey decodes a SaveDataFile written by SavedKey.Marshal, and validates the key data with ValidateFull
+func UnmarshalSavedKey(bz []byte) (*SavedKey, error) {
+	file := new(SaveDataFile)
+	if err := proto.Unmarshal(bz, file); err != nil {
+		return nil, err
+	}
+	if file.GetVersion() == 0 || SaveDataVersion < file.GetVersion() {
+		return nil, fmt.Errorf("unsupported save data version %d", file.GetVersion())
+	}
+	ec, ok := tss.GetCurveByName(tss.CurveName(file.GetCurve()))
+	if !ok {
+		return nil, fmt.Errorf("unknown curve %q", file.GetCurve())
+	}
+	parties := partyIDsFromSaveData(file.GetParties())
+	n := len(parties)
+	if int(file.GetPartyIndex()) >= n {
+		return nil, errors.New("the party index is out of range")
+	}
+	if int(file.GetThreshold()) < 1 || n <= int(file.GetThreshold()) {
+		return nil, fmt.Errorf("invalid threshold %d for %d parties", file.GetThreshold(), n)
+	}
+	if len(file.GetKs()) != n || len(file.GetNTildeJ()) != n || len(file.GetH1J()) != n || len(file.GetH2J()) != n ||
+		len(file.GetPaillierPks()) != n || len(file.GetBigXj()) != n {
+		return nil, errors.New("the save data does not cover every party")
+	}
+
+	data := NewLocalPartySaveData(n)
+	data.Xi, data.ShareID = intOrNil(file.GetXi()), intOrNil(file.GetShareId())
+	data.PaillierSK = &paillier.PrivateKey{
+		PublicKey: paillier.PublicKey{N: intOrNil(file.GetPaillierN())},
+		LambdaN:   intOrNil(file.GetPaillierLambdaN()),
+		PhiN:      intOrNil(file.GetPaillierPhiN()),
+		P:         intOrNil(file.GetPaillierP()),
+		Q:         intOrNil(file.GetPaillierQ()),
+	}
+	data.NTildei, data.H1i, data.H2i = intOrNil(file.GetNTildeI()), intOrNil(file.GetH1I()), intOrNil(file.GetH2I())
+	data.Alpha, data.Beta = intOrNil(file.GetAlpha()), intOrNil(file.GetBeta())
+	data.P, data.Q = intOrNil(file.GetP()), intOrNil(file.GetQ())
+	for j := 0; j < n; j++ {
+		data.Ks[j] = new(big.Int).SetBytes(file.GetKs()[j])
+		if data.Ks[j].Cmp(parties[j].KeyInt()) != 0 {
+			return nil, fmt.Errorf("the save data does not match party %s", parties[j])
+		}
+		data.NTildej[j] = new(big.Int).SetBytes(file.GetNTildeJ()[j])
+		data.H1j[j] = new(big.Int).SetBytes(file.GetH1J()[j])
+		data.H2j[j] = new(big.Int).SetBytes(file.GetH2J()[j])
+		data.PaillierPKs[j] = &paillier.PublicKey{N: new(big.Int).SetBytes(file.GetPaillierPks()[j])}
+	}
+	var err error
+	if data.BigXj, err = ecPointsFromSaveData(ec, file.GetBigXj()); err != nil {
+		return nil, err
+	}
+	if data.ECDSAPub, err = ecPointFromSaveData(ec, file.GetEcdsaPub()); err != nil {
+		return nil, err
+	}
+	if err = data.ValidateFull(ec, int(file.GetThreshold())); err != nil {
+		return nil, fmt.Errorf("the save data failed to validate: %v", err)
+	}
+	return &SavedKey{
+		Version:   file.GetVersion(),
+		Curve:     tss.CurveName(file.GetCurve()),
+		Threshold: int(file.GetThreshold()),
+		Epoch:     file.GetEpoch(),
+		Parties:   parties,
+		PartyID:   parties[file.GetPartyIndex()],
+		Data:      data,
+	}, nil
+}
+
+// Parameters returns the parameters for a protocol run by `signers`, or by all of the parties of the key when
+// `signers` is empty. This party must be one of the signers.
+func (k *SavedKey) Parameters(signers ...*tss.PartyID) (*tss.Parameters, error) {
+	ec, ok := tss.GetCurveByName(k.Curve)
+	if !ok {
+		return nil, fmt.Errorf("unknown curve %q", k.Curve)
+	}
+	parties, self, err := subsetOfParties(k.Parties, k.PartyID, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tss.NewParameters(ec, tss.NewPeerContext(parties), self, len(parties), k.Threshold), nil
+}
+
+// ReSharingParameters returns the parameters for this party to re-share the key as a member of `oldParties`
+// to `newParties` with `newThreshold`
+func (k *SavedKey) ReSharingParameters(oldParties, newParties tss.SortedPartyIDs, newThreshold int) (*tss.ReSharingParameters, error) {
+	ec, ok := tss.GetCurveByName(k.Curve)
+	if !ok {
+		return nil, fmt.Errorf("unknown curve %q", k.Curve)
+	}
+	parties, self, err := subsetOfParties(k.Parties, k.PartyID, oldParties)
+	if err != nil {
+		return nil, err
+	}
+	return tss.NewReSharingParameters(ec, tss.NewPeerContext(parties), tss.NewPeerContext(newParties), self,
+		len(parties), k.Threshold, len(newParties), newThreshold), nil
+}
+
+// ----- //
+
+// subsetOfParties returns fresh, sorted copies of the parties with the keys of `subset` and this party's copy
+func subsetOfParties(parties tss.SortedPartyIDs, self *tss.PartyID, subset []*tss.PartyID) (tss.SortedPartyIDs, *tss.PartyID, error) {
+	if len(subset) == 0 {
+		subset = parties
+	}
+	unsorted := make(tss.UnSortedPartyIDs, 0, len(subset))
+	var ourID *tss.PartyID
+	for _, s := range subset {
+		var found *tss.PartyID
+		for _, Pj := range parties {
+			if Pj.KeyInt().Cmp(s.KeyInt()) == 0 {
+				found = tss.NewPartyID(Pj.Id, Pj.Moniker, Pj.KeyInt())
+				break
+			}
+		}
+		if found == nil {
+			return nil, nil, fmt.Errorf("party %s does not hold a share of the key", s)
+		}
+		if found.KeyInt().Cmp(self.KeyInt()) == 0 {
+			ourID = found
+		}
+		unsorted = append(unsorted, found)
+	}
+	if ourID == nil {
+		return nil, nil, errors.New("this party must take part")
+	}
+	return tss.SortPartyIDs(unsorted), ourID, nil
+}
+
+func partyIDsToSaveData(parties tss.SortedPartyIDs) []*SaveDataFile_PartyID {
+	out := make([]*SaveDataFile_PartyID, len(parties))
+	for j, Pj := range parties {
+		out[j] = &SaveDataFile_PartyID{Id: Pj.Id, Moniker: Pj.Moniker, Key: Pj.Key}
+	}
+	return out
+}
+
+func partyIDsFromSaveData(in []*SaveDataFile_PartyID) tss.SortedPartyIDs {
+	unsorted := make(tss.UnSortedPartyIDs, len(in))
+	for j, p := range in {
+		unsorted[j] = tss.NewPartyID(p.GetId(), p.GetMoniker(), new(big.Int).SetBytes(p.GetKey()))
+	}
+	return tss.SortPartyIDs(unsorted)
+}
+
+func ecPointsToSaveData(points []*crypto.ECPoint) ([]*SaveDataFile_ECPoint, error) {
+	out := make([]*SaveDataFile_ECPoint, len(points))
+	for j, point := range points {
+		if point == nil {
+			return nil, errors.New("the save data is incomplete")
+		}
+		out[j] = &SaveDataFile_ECPoint{X: point.X().Bytes(), Y: point.Y().Bytes()}
+	}
+	return out, nil
+}
+
+func ecPointsFromSaveData(ec elliptic.Curve, in []*SaveDataFile_ECPoint) ([]*crypto.ECPoint, error) {
+	out := make([]*crypto.ECPoint, len(in))
+	for j, p := range in {
+		point, err := ecPointFromSaveData(ec, p)
+		if err != nil {
+			return nil, err
+		}
+		out[j] = point
+	}
+	return out, nil
+}
+
+func ecPointFromSaveData(ec elliptic.Curve, p *SaveDataFile_ECPoint) (*crypto.ECPoint, error) {
+	if p == nil {
+		return nil, errors.New("the save data is missing a point")
+	}
+	return crypto.NewECPoint(ec, new(big.Int).SetBytes(p.GetX()), new(big.Int).SetBytes(p.GetY()))
+}
+
+func bytesOrNil(i *big.Int) []byte {
+	if i == nil {
+		return nil
+	}
+	return i.Bytes()
+}
+
+func intOrNil(bz []byte) *big.Int {
+	if len(bz) == 0 {
+		return nil
+	}
+	return new(big.Int).SetBytes(bz)
+}
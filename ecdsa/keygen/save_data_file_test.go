@@ -0,0 +1,77 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto/sealed"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func TestSavedKeyRoundTrip(t *testing.T) {
+	keys, pIDs, err := LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(pIDs), pIDs[1], len(pIDs), testThreshold)
+	saved, err := NewSavedKey(params, keys[1], 7)
+	assert.NoError(t, err)
+	bz, err := saved.Marshal()
+	assert.NoError(t, err)
+
+	loaded, err := UnmarshalSavedKey(bz)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(SaveDataVersion), loaded.Version)
+	assert.Equal(t, tss.Secp256k1, loaded.Curve)
+	assert.Equal(t, testThreshold, loaded.Threshold)
+	assert.Equal(t, uint64(7), loaded.Epoch)
+	assert.Equal(t, pIDs[1].Id, loaded.PartyID.Id)
+	assert.Equal(t, len(pIDs), len(loaded.Parties))
+	assert.Equal(t, keys[1], loaded.Data)
+
+	// parameters for a subset of the parties
+	signParams, err := loaded.Parameters(pIDs[3], pIDs[1], pIDs[0])
+	assert.NoError(t, err)
+	assert.Equal(t, 3, signParams.PartyCount())
+	assert.Equal(t, testThreshold, signParams.Threshold())
+	assert.Equal(t, 1, signParams.PartyID().Index)
+	_, err = loaded.Parameters(pIDs[2], pIDs[3], pIDs[4])
+	assert.Error(t, err, "this party must take part")
+
+	// a newer version is rejected
+	file := new(SaveDataFile)
+	assert.NoError(t, proto.Unmarshal(bz, file))
+	file.Version = SaveDataVersion + 1
+	bz, err = proto.Marshal(file)
+	assert.NoError(t, err)
+	_, err = UnmarshalSavedKey(bz)
+	assert.Error(t, err)
+}
+
+func TestSealOpen(t *testing.T) {
+	keys, pIDs, err := LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), testThreshold)
+	saved, err := NewSavedKey(params, keys[0], 1)
+	assert.NoError(t, err)
+	kek, err := sealed.NewPassphraseKEK([]byte("passphrase"))
+	assert.NoError(t, err)
+
+	container, err := Seal(saved, kek)
+	assert.NoError(t, err)
+	md, err := sealed.ReadMetadata(container)
+	assert.NoError(t, err)
+	assert.True(t, keys[0].ECDSAPub.Equals(md.PublicKey), "the public key must be readable without the passphrase")
+
+	opened, err := Open(container, kek)
+	assert.NoError(t, err)
+	assert.Equal(t, keys[0], opened.Data)
+}
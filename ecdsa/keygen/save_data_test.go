@@ -0,0 +1,94 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func TestLocalPreParamsValidateFull(t *testing.T) {
+	keys, _, err := LoadKeygenTestFixtures(1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	preParams := keys[0].LocalPreParams
+	assert.NoError(t, preParams.ValidateFull())
+
+	plusOne := func(x *big.Int) *big.Int { return new(big.Int).Add(x, big.NewInt(1)) }
+	tests := map[string]func(pp *LocalPreParams){
+		"missing alpha": func(pp *LocalPreParams) { pp.Alpha = nil },
+		"NTildei":       func(pp *LocalPreParams) { pp.NTildei = plusOne(pp.NTildei) },
+		"P not prime":   func(pp *LocalPreParams) { pp.P = plusOne(pp.P) },
+		// a prime whose 2P+1 is not prime
+		"P not a Germain prime": func(pp *LocalPreParams) { pp.P = big.NewInt(7) },
+		"H1i and H2i swapped":   func(pp *LocalPreParams) { pp.H1i, pp.H2i = pp.H2i, pp.H1i },
+		"H2i":                   func(pp *LocalPreParams) { pp.H2i = new(big.Int).Mul(pp.H2i, pp.H2i) },
+		"beta":                  func(pp *LocalPreParams) { pp.Beta = plusOne(pp.Beta) },
+		// -1 is a unit of order 2, outside of the subgroup
+		"H1i of order 2": func(pp *LocalPreParams) { pp.H1i = new(big.Int).Sub(pp.NTildei, big.NewInt(1)) },
+		"Paillier N": func(pp *LocalPreParams) {
+			sk := *pp.PaillierSK
+			sk.N = plusOne(sk.N)
+			pp.PaillierSK = &sk
+		},
+		"Paillier LambdaN": func(pp *LocalPreParams) {
+			sk := *pp.PaillierSK
+			sk.LambdaN = sk.PhiN
+			pp.PaillierSK = &sk
+		},
+	}
+	for name, corrupt := range tests {
+		pp := preParams
+		corrupt(&pp)
+		assert.Error(t, pp.ValidateFull(), name)
+	}
+}
+
+func TestLocalPartySaveDataValidateFull(t *testing.T) {
+	keys, pIDs, err := LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	ec := tss.S256()
+	for _, key := range keys {
+		assert.NoError(t, key.ValidateFull(ec, testThreshold))
+	}
+	// the data of a subset of the parties, as given to signing
+	subset := BuildLocalSaveDataSubset(keys[0], pIDs[:testThreshold+1])
+	assert.NoError(t, subset.ValidateFull(ec, testThreshold))
+	assert.Error(t, keys[0].ValidateFull(ec, testThreshold-1), "the BigXj lie on a polynomial of degree threshold")
+
+	// fresh returns save data whose arrays may be changed without affecting the fixtures
+	fresh := func() LocalPartySaveData {
+		return BuildLocalSaveDataSubset(keys[0], pIDs)
+	}
+	tests := map[string]func(data *LocalPartySaveData){
+		"Xi": func(data *LocalPartySaveData) { data.Xi = new(big.Int).Add(data.Xi, big.NewInt(1)) },
+		"BigXj of another party": func(data *LocalPartySaveData) {
+			data.BigXj[4] = crypto.ScalarBaseMult(ec, big.NewInt(42))
+		},
+		"ECDSAPub": func(data *LocalPartySaveData) { data.ECDSAPub = crypto.ScalarBaseMult(ec, big.NewInt(42)) },
+		"lengths":  func(data *LocalPartySaveData) { data.H1j = data.H1j[:3] },
+		"duplicate Ks": func(data *LocalPartySaveData) {
+			data.Ks[1] = data.Ks[2]
+		},
+		"ShareID": func(data *LocalPartySaveData) { data.ShareID = big.NewInt(42) },
+		"own NTildej": func(data *LocalPartySaveData) {
+			data.NTildej[0], data.H1j[0], data.H2j[0] = data.NTildej[1], data.H1j[1], data.H2j[1]
+		},
+		"own PaillierPK": func(data *LocalPartySaveData) { data.PaillierPKs[0] = &paillier.PublicKey{N: data.PaillierPKs[1].N} },
+		"pre-params":     func(data *LocalPartySaveData) { data.H2i = data.H1i },
+	}
+	for name, corrupt := range tests {
+		data := fresh()
+		corrupt(&data)
+		assert.Error(t, data.ValidateFull(ec, testThreshold), name)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto/sealed"
+)
+
+const (
+	// SealedContentType identifies sealed containers holding ECDSA save data
+	SealedContentType = "ecdsa-keygen-save-data"
+)
+
+// Seal encrypts the key for storage with `kek`. The curve, public key and epoch of the key are kept in the
+// authenticated metadata of the container.
+func Seal(key *SavedKey, kek sealed.KeyEncryptionKey) ([]byte, error) {
+	if key.Data.ECDSAPub == nil {
+		return nil, errors.New("the save data is incomplete")
+	}
+	bz, err := key.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	md := sealed.Metadata{
+		ContentType: SealedContentType,
+		Curve:       key.Curve,
+		PublicKey:   key.Data.ECDSAPub,
+		Epoch:       key.Epoch,
+	}
+	return sealed.Seal(bz, md, kek)
+}
+
+// Open decrypts a container created by Seal with the same `kek`
+func Open(container []byte, kek sealed.KeyEncryptionKey) (*SavedKey, error) {
+	bz, md, err := sealed.Open(container, kek)
+	if err != nil {
+		return nil, err
+	}
+	if md.ContentType != SealedContentType {
+		return nil, fmt.Errorf("the container holds %q, not %q", md.ContentType, SealedContentType)
+	}
+	key, err := UnmarshalSavedKey(bz)
+	if err != nil {
+		return nil, err
+	}
+	if key.Curve != md.Curve || md.PublicKey == nil || !md.PublicKey.Equals(key.Data.ECDSAPub) || key.Epoch != md.Epoch {
+		return nil, errors.New("the save data does not match the metadata of the container")
+	}
+	return key, nil
+}
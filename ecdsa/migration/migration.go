@@ -0,0 +1,169 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package migration upgrades ECDSA save data produced by older versions of tss-lib.
+//
+// Older save data lacks some of the pre-params that the current protocols need to prove their Paillier keys and
+// NTilde. What the secrets of a party determine is restored locally by Upgrade: the factors of the Paillier modulus
+// follow from PhiN. The factors of NTilde and the discrete logs Alpha and Beta were never saved before the DLN
+// proofs, and cannot be recovered; such a party must take part in a refresh with new pre-params, which
+// NewRefreshParty sets up. Once the save data of every party is current, the parties no longer need
+// Parameters.SetNoProofMod and SetNoProofFac.
+package migration
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/refresh"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// Version identifies the version of tss-lib that produced save data, by the pre-params it holds
+type Version int
+
+const (
+	// the save data lacks fields that every version saved, such as the Paillier key or NTildei
+	VersionUnknown Version = iota
+	// saved before the DLN proofs: the pre-params lack Alpha, Beta and the factors P and Q of NTildei
+	VersionNoDLNProof
+	// saved before tss-lib v2.0: the Paillier secret key lacks its factors P and Q
+	VersionNoPaillierFactors
+	// saved by this version
+	VersionCurrent
+)
+
+const (
+	// the bit length of the Paillier modulus and NTilde checked by the other parties
+	modulusBitLen = 2048
+)
+
+// ErrRefreshRequired is the cause of the error returned by Upgrade when the save data cannot be upgraded locally
+var ErrRefreshRequired = errors.New("the save data must be refreshed with new pre-params")
+
+var one = big.NewInt(1)
+
+// DetectVersion returns the version of tss-lib that produced `data`
+func DetectVersion(data keygen.LocalPartySaveData) Version {
+	switch {
+	case !data.Validate() || data.PaillierSK.N == nil:
+		return VersionUnknown
+	case data.Alpha == nil || data.Beta == nil || data.P == nil || data.Q == nil:
+		return VersionNoDLNProof
+	case data.PaillierSK.P == nil || data.PaillierSK.Q == nil:
+		return VersionNoPaillierFactors
+	default:
+		return VersionCurrent
+	}
+}
+
+func (v Version) String() string {
+	switch v {
+	case VersionNoDLNProof:
+		return "no-dln-proof"
+	case VersionNoPaillierFactors:
+		return "no-paillier-factors"
+	case VersionCurrent:
+		return "current"
+	default:
+		return "unknown"
+	}
+}
+
+// Upgrade returns a copy of `data`, a key on `ec` shared with `threshold`, with the pre-params that its secrets
+// determine filled in. The result passes LocalPartySaveData.ValidateFull. When the missing pre-params cannot be
+// recovered, or the Paillier key or NTilde would be rejected by the other parties, the error has ErrRefreshRequired as
+// its cause and the party should migrate with NewRefreshParty.
+func Upgrade(data keygen.LocalPartySaveData, ec elliptic.Curve, threshold int) (keygen.LocalPartySaveData, error) {
+	switch version := DetectVersion(data); version {
+	case VersionUnknown:
+		return data, errors.New("the save data is incomplete")
+	case VersionNoDLNProof:
+		return data, fmt.Errorf("the factors of NTilde were not saved: %w", ErrRefreshRequired)
+	case VersionNoPaillierFactors:
+		P, Q, err := paillierFactors(data.PaillierSK)
+		if err != nil {
+			return data, err
+		}
+		sk := *data.PaillierSK
+		sk.P, sk.Q = P, Q
+		data.PaillierSK = &sk
+	}
+
+	sk := data.PaillierSK
+	if sk.N.BitLen() != modulusBitLen || data.NTildei.BitLen() != modulusBitLen {
+		return data, fmt.Errorf("the Paillier modulus or NTilde is not %d bits long: %w", modulusBitLen, ErrRefreshRequired)
+	}
+	// the mod proof of the Paillier key shows that N is a Blum integer
+	if sk.P.Bit(0) != 1 || sk.P.Bit(1) != 1 || sk.Q.Bit(0) != 1 || sk.Q.Bit(1) != 1 {
+		return data, fmt.Errorf("the Paillier factors are not both 3 mod 4: %w", ErrRefreshRequired)
+	}
+	if err := data.ValidateFull(ec, threshold); err != nil {
+		return data, err
+	}
+	return data, nil
+}
+
+// NewRefreshParty returns a party of the refresh protocol which migrates `key`. Save data which Upgrade cannot
+// upgrade must be given new pre-params in `optionalPreParams`, which replace its Paillier key and NTilde; otherwise
+// the upgraded key takes part, rotating its pre-params only when some are given. Every party holding the key must
+// take part, whether or not its own save data needs the migration.
+func NewRefreshParty(
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- *keygen.LocalPartySaveData,
+	optionalPreParams ...keygen.LocalPreParams,
+) (tss.Party, error) {
+	if 1 < len(optionalPreParams) {
+		return nil, errors.New("migration.NewRefreshParty expected 0 or 1 item in `optionalPreParams`")
+	}
+	upgraded, err := Upgrade(key, params.EC(), params.Threshold())
+	switch {
+	case err == nil:
+		key = upgraded
+	case errors.Is(err, ErrRefreshRequired) && 0 < len(optionalPreParams):
+	default:
+		return nil, err
+	}
+	if 0 < len(optionalPreParams) {
+		if err := optionalPreParams[0].ValidateFull(); err != nil {
+			return nil, fmt.Errorf("the new pre-params failed to validate: %v", err)
+		}
+	}
+	return refresh.NewLocalParty(params, key, out, end, optionalPreParams...), nil
+}
+
+// ----- //
+
+// paillierFactors recovers the factors of the Paillier modulus from PhiN = (P-1)(Q-1), as P+Q = N-PhiN+1
+func paillierFactors(sk *paillier.PrivateKey) (P, Q *big.Int, err error) {
+	if sk.PhiN == nil {
+		return nil, nil, fmt.Errorf("the Paillier secret key lacks PhiN: %w", ErrRefreshRequired)
+	}
+	sum := new(big.Int).Sub(sk.N, sk.PhiN)
+	sum.Add(sum, one)
+	// P and Q are the roots of x² - (P+Q)x + N
+	disc := new(big.Int).Mul(sum, sum)
+	disc.Sub(disc, new(big.Int).Lsh(sk.N, 2))
+	if disc.Sign() < 0 {
+		return nil, nil, errors.New("the Paillier PhiN does not match N")
+	}
+	root := new(big.Int).Sqrt(disc)
+	if new(big.Int).Mul(root, root).Cmp(disc) != 0 {
+		return nil, nil, errors.New("the Paillier PhiN does not match N")
+	}
+	P = new(big.Int).Rsh(new(big.Int).Add(sum, root), 1)
+	Q = new(big.Int).Rsh(new(big.Int).Sub(sum, root), 1)
+	if new(big.Int).Mul(P, Q).Cmp(sk.N) != 0 {
+		return nil, nil, errors.New("the Paillier PhiN does not match N")
+	}
+	return P, Q, nil
+}
@@ -0,0 +1,154 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package migration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/test"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+// legacy returns a copy of `key` as the given older version of tss-lib would have saved it
+func legacy(key keygen.LocalPartySaveData, version Version) keygen.LocalPartySaveData {
+	sk := *key.PaillierSK
+	sk.P, sk.Q = nil, nil
+	key.PaillierSK = &sk
+	if version == VersionNoDLNProof {
+		key.Alpha, key.Beta, key.P, key.Q = nil, nil, nil, nil
+	}
+	return key
+}
+
+func TestUpgrade(t *testing.T) {
+	keys, _, err := keygen.LoadKeygenTestFixtures(1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	key := keys[0]
+	ec := tss.S256()
+
+	assert.Equal(t, VersionCurrent, DetectVersion(key))
+	upgraded, err := Upgrade(key, ec, testThreshold)
+	assert.NoError(t, err)
+	assert.Equal(t, key, upgraded)
+
+	// the factors of the Paillier modulus are recovered
+	old := legacy(key, VersionNoPaillierFactors)
+	assert.Equal(t, VersionNoPaillierFactors, DetectVersion(old))
+	upgraded, err = Upgrade(old, ec, testThreshold)
+	assert.NoError(t, err)
+	assert.Equal(t, VersionCurrent, DetectVersion(upgraded))
+	assert.ElementsMatch(t, []string{key.PaillierSK.P.String(), key.PaillierSK.Q.String()},
+		[]string{upgraded.PaillierSK.P.String(), upgraded.PaillierSK.Q.String()})
+	assert.Nil(t, old.PaillierSK.P, "the input must not be modified")
+
+	// the factors of NTilde are not
+	old = legacy(key, VersionNoDLNProof)
+	assert.Equal(t, VersionNoDLNProof, DetectVersion(old))
+	_, err = Upgrade(old, ec, testThreshold)
+	assert.True(t, errors.Is(err, ErrRefreshRequired))
+
+	old.NTildei = nil
+	assert.Equal(t, VersionUnknown, DetectVersion(old))
+	_, err = Upgrade(old, ec, testThreshold)
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrRefreshRequired))
+}
+
+func TestE2EConcurrentRefresh(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// parties 0 and 1 need new pre-params, and swap theirs; party 2 is upgraded locally
+	olds := make([]keygen.LocalPartySaveData, len(keys))
+	copy(olds, keys)
+	olds[0], olds[1] = legacy(keys[0], VersionNoDLNProof), legacy(keys[1], VersionNoDLNProof)
+	olds[2] = legacy(keys[2], VersionNoPaillierFactors)
+	preParams := map[int]keygen.LocalPreParams{0: keys[1].LocalPreParams, 1: keys[0].LocalPreParams}
+
+	p2pCtx := tss.NewPeerContext(pIDs)
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan *keygen.LocalPartySaveData, len(pIDs))
+
+	_, err = NewRefreshParty(tss.NewParameters(tss.S256(), p2pCtx, pIDs[0], len(pIDs), testThreshold), olds[0], outCh, endCh)
+	assert.True(t, errors.Is(err, ErrRefreshRequired), "new pre-params are required")
+
+	parties := make([]tss.Party, 0, len(pIDs))
+	for i := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+		var P tss.Party
+		if pp, ok := preParams[i]; ok {
+			P, err = NewRefreshParty(params, olds[i], outCh, endCh, pp)
+		} else {
+			P, err = NewRefreshParty(params, olds[i], outCh, endCh)
+		}
+		if !assert.NoError(t, err) {
+			return
+		}
+		parties = append(parties, P)
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	migrated := make([]keygen.LocalPartySaveData, len(pIDs))
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != msg.GetFrom().Index {
+						go test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case save := <-endCh:
+			index, err := save.OriginalIndex()
+			assert.NoError(t, err)
+			migrated[index] = *save
+			ended++
+		}
+	}
+
+	for i, save := range migrated {
+		assert.Equal(t, VersionCurrent, DetectVersion(save))
+		assert.NoError(t, save.ValidateFull(tss.S256(), testThreshold), "party %d", i)
+		assert.True(t, keys[i].ECDSAPub.Equals(save.ECDSAPub), "the public key must not change")
+		for j := range migrated {
+			if pp, ok := preParams[j]; ok {
+				assert.Equal(t, pp.NTildei, save.NTildej[j])
+			} else {
+				assert.Equal(t, keys[j].NTildei, save.NTildej[j])
+			}
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.20.3
+// source: protob/ecdsa-onlinesigning.proto
+
+package onlinesigning
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Represents a BROADCAST message sent to all parties during Round 1 of the ECDSA TSS online signing protocol.
+type OnlineSignRound1Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	S []byte `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (x *OnlineSignRound1Message) Reset() {
+	*x = OnlineSignRound1Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_onlinesigning_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnlineSignRound1Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnlineSignRound1Message) ProtoMessage() {}
+
+func (x *OnlineSignRound1Message) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_onlinesigning_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnlineSignRound1Message.ProtoReflect.Descriptor instead.
+func (*OnlineSignRound1Message) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_onlinesigning_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OnlineSignRound1Message) GetS() []byte {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
+var File_protob_ecdsa_onlinesigning_proto protoreflect.FileDescriptor
+
+var file_protob_ecdsa_onlinesigning_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2f, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2d, 0x6f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x22, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73, 0x73, 0x6c,
+	0x69, 0x62, 0x2e, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2e, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x27, 0x0a, 0x17, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x73, 0x42,
+	0x15, 0x5a, 0x13, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protob_ecdsa_onlinesigning_proto_rawDescOnce sync.Once
+	file_protob_ecdsa_onlinesigning_proto_rawDescData = file_protob_ecdsa_onlinesigning_proto_rawDesc
+)
+
+func file_protob_ecdsa_onlinesigning_proto_rawDescGZIP() []byte {
+	file_protob_ecdsa_onlinesigning_proto_rawDescOnce.Do(func() {
+		file_protob_ecdsa_onlinesigning_proto_rawDescData = protoimpl.X.CompressGZIP(file_protob_ecdsa_onlinesigning_proto_rawDescData)
+	})
+	return file_protob_ecdsa_onlinesigning_proto_rawDescData
+}
+
+var file_protob_ecdsa_onlinesigning_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_protob_ecdsa_onlinesigning_proto_goTypes = []interface{}{
+	(*OnlineSignRound1Message)(nil), // 0: binance.tsslib.ecdsa.onlinesigning.OnlineSignRound1Message
+}
+var file_protob_ecdsa_onlinesigning_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_protob_ecdsa_onlinesigning_proto_init() }
+func file_protob_ecdsa_onlinesigning_proto_init() {
+	if File_protob_ecdsa_onlinesigning_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protob_ecdsa_onlinesigning_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnlineSignRound1Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protob_ecdsa_onlinesigning_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protob_ecdsa_onlinesigning_proto_goTypes,
+		DependencyIndexes: file_protob_ecdsa_onlinesigning_proto_depIdxs,
+		MessageInfos:      file_protob_ecdsa_onlinesigning_proto_msgTypes,
+	}.Build()
+	File_protob_ecdsa_onlinesigning_proto = out.File
+	file_protob_ecdsa_onlinesigning_proto_rawDesc = nil
+	file_protob_ecdsa_onlinesigning_proto_goTypes = nil
+	file_protob_ecdsa_onlinesigning_proto_depIdxs = nil
+}
@@ -0,0 +1,119 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package onlinesigning
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func (round *finalization) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	N := round.Params().EC().Params().N
+	modN := common.ModInt(N)
+	sumS := round.temp.si
+
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
+	for j, Pj := range round.Parties().IDs() {
+		round.ok[j] = true
+		if j == round.PartyID().Index {
+			continue
+		}
+		r1msg := round.temp.signRound1Messages[j].Content().(*OnlineSignRound1Message)
+		sj := r1msg.UnmarshalS()
+		if !round.verifyPartialSignature(j, sj) {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		sumS = modN.Add(sumS, sj)
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(errors.New("partial signature verification failed"), culprits...)
+	}
+
+	R := round.presig.R
+	recid := 0
+	// byte v = if(R.X > curve.N) then 2 else 0) | (if R.Y.IsEven then 0 else 1);
+	if R.X().Cmp(N) > 0 {
+		recid = 2
+	}
+	if R.Y().Bit(0) != 0 {
+		recid |= 1
+	}
+
+	// low-S normalization, see the note in ecdsa/signing finalization
+	halfN := new(big.Int).Rsh(N, 1)
+	if sumS.Cmp(halfN) > 0 {
+		sumS.Sub(N, sumS)
+		recid ^= 1
+	}
+
+	// save the signature for final output
+	bitSizeInBytes := round.Params().EC().Params().BitSize / 8
+	round.data.R = common.PadToLengthBytesInPlace(round.temp.r.Bytes(), bitSizeInBytes)
+	round.data.S = common.PadToLengthBytesInPlace(sumS.Bytes(), bitSizeInBytes)
+	round.data.Signature = append(round.data.R, round.data.S...)
+	round.data.SignatureRecovery = []byte{byte(recid)}
+	round.data.M = round.temp.m.Bytes()
+
+	pk := ecdsa.PublicKey{
+		Curve: round.Params().EC(),
+		X:     round.presig.ECDSAPub.X(),
+		Y:     round.presig.ECDSAPub.Y(),
+	}
+	ok := ecdsa.Verify(&pk, round.temp.m.Bytes(), round.temp.r, sumS)
+	if !ok {
+		return round.WrapError(fmt.Errorf("signature verification failed"))
+	}
+
+	round.out.Finish(round.data)
+
+	return nil
+}
+
+func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *finalization) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *finalization) NextRound() tss.Round {
+	return nil // finished!
+}
+
+// ----- //
+
+// verifyPartialSignature checks s_j * R == m * (k_j * R) + r * (chi_j * R) using the public values of the presignature
+func (round *finalization) verifyPartialSignature(j int, sj *big.Int) bool {
+	if sj.Sign() == 0 || sj.Cmp(round.Params().EC().Params().N) >= 0 {
+		return false
+	}
+	left := round.presig.R.ScalarMult(sj)
+	right := round.presig.BigChij[j].ScalarMult(round.temp.r)
+	if round.temp.m.Sign() != 0 {
+		var err error
+		if right, err = right.Add(round.presig.BigKj[j].ScalarMult(round.temp.m)); err != nil {
+			return false
+		}
+	}
+	return left.Equals(right)
+}
@@ -0,0 +1,145 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package onlinesigning
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/presigning"
+	"github.com/bnb-chain/tss-lib/v2/test"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+// runParties routes messages between the given parties until `done` is closed
+func runParties(t *testing.T, parties []tss.Party, outCh chan tss.Message, errCh chan *tss.Error, done <-chan struct{}) {
+	for _, P := range parties {
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	for {
+		select {
+		case <-done:
+			return
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go test.SharedPartyUpdater(P, msg, errCh)
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		}
+	}
+}
+
+func presign(t *testing.T, keys []keygen.LocalPartySaveData, signPIDs tss.SortedPartyIDs) []*presigning.Presignature {
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *presigning.Presignature, len(signPIDs))
+
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		parties = append(parties, presigning.NewLocalParty(params, keys[i], outCh, endCh))
+	}
+	presigs := make([]*presigning.Presignature, len(signPIDs))
+	done := make(chan struct{})
+	go func() {
+		for range signPIDs {
+			presig := <-endCh
+			// the parties finish out of order, match each result to its party by chi_i * R
+			for i := range parties {
+				if presig.BigChij[i].Equals(presig.R.ScalarMult(presig.ChiI)) {
+					presigs[i] = presig
+				}
+			}
+		}
+		close(done)
+	}()
+	runParties(t, parties, outCh, errCh, done)
+	return presigs
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// PHASE: presigning (offline)
+	presigs := presign(t, keys, signPIDs)
+
+	// PHASE: online signing
+	msg := big.NewInt(42)
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		parties = append(parties, NewLocalParty(msg, params, presigs[i], outCh, endCh))
+	}
+	sigs := make([]*common.SignatureData, 0, len(signPIDs))
+	done := make(chan struct{})
+	go func() {
+		for range signPIDs {
+			sigs = append(sigs, <-endCh)
+		}
+		close(done)
+	}()
+	runParties(t, parties, outCh, errCh, done)
+
+	pk := ecdsa.PublicKey{
+		Curve: tss.EC(),
+		X:     keys[0].ECDSAPub.X(),
+		Y:     keys[0].ECDSAPub.Y(),
+	}
+	for _, sig := range sigs {
+		assert.Equal(t, sigs[0].Signature, sig.Signature, "all parties must output the same signature")
+	}
+	ok := ecdsa.Verify(&pk, msg.Bytes(), new(big.Int).SetBytes(sigs[0].R), new(big.Int).SetBytes(sigs[0].S))
+	assert.True(t, ok, "ecdsa verify must pass")
+
+	// a consumed presignature must be rejected
+	for _, presig := range presigs {
+		assert.Error(t, presig.Validate(), "presignature must be consumed after signing")
+	}
+	params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[0], len(signPIDs), testThreshold)
+	P := NewLocalParty(big.NewInt(43), params, presigs[0], outCh, endCh)
+	assert.NotNil(t, P.Start(), "signing with a consumed presignature must fail")
+}
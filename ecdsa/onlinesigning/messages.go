@@ -0,0 +1,50 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package onlinesigning
+
+import (
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into ecdsa-onlinesigning.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that online signing messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*OnlineSignRound1Message)(nil),
+	}
+)
+
+// ----- //
+
+func NewOnlineSignRound1Message(
+	from *tss.PartyID,
+	si *big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &OnlineSignRound1Message{
+		S: si.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *OnlineSignRound1Message) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.S)
+}
+
+func (m *OnlineSignRound1Message) UnmarshalS() *big.Int {
+	return new(big.Int).SetBytes(m.S)
+}
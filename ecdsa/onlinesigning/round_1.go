@@ -0,0 +1,96 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package onlinesigning
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/presigning"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// round 1 represents the online phase of signing: each party broadcasts s_i = m*k_i + r*chi_i
+func newRound1(params *tss.Parameters, presig *presigning.Presignature, data *common.SignatureData, temp *localTempData, out *tss.Outbox) tss.Round {
+	return &round1{
+		&base{params, presig, data, temp, out, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+
+	// we accept the converted big.Int of the hashed message, see the note in ecdsa/signing round 1
+	if round.temp.m == nil || round.temp.m.Cmp(round.Params().EC().Params().N) >= 0 {
+		return round.WrapError(errors.New("hashed message is not valid"))
+	}
+	if err := round.presig.Validate(); err != nil {
+		return round.WrapError(err)
+	}
+	// the presignature may only be used by the exact signer set that produced it
+	Ks := round.Parties().IDs().Keys()
+	if len(Ks) != len(round.presig.Ks) {
+		return round.WrapError(fmt.Errorf("presignature was made by %d signers but %d are present", len(round.presig.Ks), len(Ks)))
+	}
+	for j, Kj := range Ks {
+		if Kj.Cmp(round.presig.Ks[j]) != 0 {
+			return round.WrapError(errors.New("presignature was made by a different set of signers"), round.Parties().IDs()[j])
+		}
+	}
+
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	modN := common.ModInt(round.Params().EC().Params().N)
+	r := new(big.Int).Mod(round.presig.R.X(), round.Params().EC().Params().N)
+	si := modN.Add(modN.Mul(round.temp.m, round.presig.Ki), modN.Mul(r, round.presig.ChiI))
+
+	// consume the presignature so that it can never be used to sign another message
+	round.presig.Ki = nil
+	round.presig.ChiI = nil
+
+	round.temp.r = r
+	round.temp.si = si
+
+	i := round.PartyID().Index
+	round.ok[i] = true
+
+	r1msg := NewOnlineSignRound1Message(round.PartyID(), si)
+	round.temp.signRound1Messages[i] = r1msg
+	round.out.Send(r1msg)
+
+	return nil
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.signRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*OnlineSignRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &finalization{round}
+}
@@ -0,0 +1,571 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.20.3
+// source: protob/ecdsa-presigning.proto
+
+package presigning
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Represents a P2P message sent to each party during Round 1 of the ECDSA TSS presigning protocol.
+type PresignRound1Message1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	C               []byte   `protobuf:"bytes,1,opt,name=c,proto3" json:"c,omitempty"`
+	RangeProofAlice [][]byte `protobuf:"bytes,2,rep,name=range_proof_alice,json=rangeProofAlice,proto3" json:"range_proof_alice,omitempty"`
+}
+
+func (x *PresignRound1Message1) Reset() {
+	*x = PresignRound1Message1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_presigning_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignRound1Message1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignRound1Message1) ProtoMessage() {}
+
+func (x *PresignRound1Message1) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_presigning_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignRound1Message1.ProtoReflect.Descriptor instead.
+func (*PresignRound1Message1) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_presigning_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PresignRound1Message1) GetC() []byte {
+	if x != nil {
+		return x.C
+	}
+	return nil
+}
+
+func (x *PresignRound1Message1) GetRangeProofAlice() [][]byte {
+	if x != nil {
+		return x.RangeProofAlice
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent to all parties during Round 1 of the ECDSA TSS presigning protocol.
+type PresignRound1Message2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *PresignRound1Message2) Reset() {
+	*x = PresignRound1Message2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_presigning_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignRound1Message2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignRound1Message2) ProtoMessage() {}
+
+func (x *PresignRound1Message2) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_presigning_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignRound1Message2.ProtoReflect.Descriptor instead.
+func (*PresignRound1Message2) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_presigning_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PresignRound1Message2) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+// Represents a P2P message sent to each party during Round 2 of the ECDSA TSS presigning protocol.
+type PresignRound2Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	C1         []byte   `protobuf:"bytes,1,opt,name=c1,proto3" json:"c1,omitempty"`
+	C2         []byte   `protobuf:"bytes,2,opt,name=c2,proto3" json:"c2,omitempty"`
+	ProofBob   [][]byte `protobuf:"bytes,3,rep,name=proof_bob,json=proofBob,proto3" json:"proof_bob,omitempty"`
+	ProofBobWc [][]byte `protobuf:"bytes,4,rep,name=proof_bob_wc,json=proofBobWc,proto3" json:"proof_bob_wc,omitempty"`
+}
+
+func (x *PresignRound2Message) Reset() {
+	*x = PresignRound2Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_presigning_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignRound2Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignRound2Message) ProtoMessage() {}
+
+func (x *PresignRound2Message) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_presigning_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignRound2Message.ProtoReflect.Descriptor instead.
+func (*PresignRound2Message) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_presigning_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PresignRound2Message) GetC1() []byte {
+	if x != nil {
+		return x.C1
+	}
+	return nil
+}
+
+func (x *PresignRound2Message) GetC2() []byte {
+	if x != nil {
+		return x.C2
+	}
+	return nil
+}
+
+func (x *PresignRound2Message) GetProofBob() [][]byte {
+	if x != nil {
+		return x.ProofBob
+	}
+	return nil
+}
+
+func (x *PresignRound2Message) GetProofBobWc() [][]byte {
+	if x != nil {
+		return x.ProofBobWc
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent to all parties during Round 3 of the ECDSA TSS presigning protocol.
+type PresignRound3Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delta []byte `protobuf:"bytes,1,opt,name=delta,proto3" json:"delta,omitempty"`
+}
+
+func (x *PresignRound3Message) Reset() {
+	*x = PresignRound3Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_presigning_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignRound3Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignRound3Message) ProtoMessage() {}
+
+func (x *PresignRound3Message) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_presigning_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignRound3Message.ProtoReflect.Descriptor instead.
+func (*PresignRound3Message) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_presigning_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PresignRound3Message) GetDelta() []byte {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent to all parties during Round 4 of the ECDSA TSS presigning protocol.
+type PresignRound4Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeCommitment [][]byte `protobuf:"bytes,1,rep,name=de_commitment,json=deCommitment,proto3" json:"de_commitment,omitempty"`
+	ProofAlphaX  []byte   `protobuf:"bytes,2,opt,name=proof_alpha_x,json=proofAlphaX,proto3" json:"proof_alpha_x,omitempty"`
+	ProofAlphaY  []byte   `protobuf:"bytes,3,opt,name=proof_alpha_y,json=proofAlphaY,proto3" json:"proof_alpha_y,omitempty"`
+	ProofT       []byte   `protobuf:"bytes,4,opt,name=proof_t,json=proofT,proto3" json:"proof_t,omitempty"`
+}
+
+func (x *PresignRound4Message) Reset() {
+	*x = PresignRound4Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_presigning_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignRound4Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignRound4Message) ProtoMessage() {}
+
+func (x *PresignRound4Message) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_presigning_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignRound4Message.ProtoReflect.Descriptor instead.
+func (*PresignRound4Message) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_presigning_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PresignRound4Message) GetDeCommitment() [][]byte {
+	if x != nil {
+		return x.DeCommitment
+	}
+	return nil
+}
+
+func (x *PresignRound4Message) GetProofAlphaX() []byte {
+	if x != nil {
+		return x.ProofAlphaX
+	}
+	return nil
+}
+
+func (x *PresignRound4Message) GetProofAlphaY() []byte {
+	if x != nil {
+		return x.ProofAlphaY
+	}
+	return nil
+}
+
+func (x *PresignRound4Message) GetProofT() []byte {
+	if x != nil {
+		return x.ProofT
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent to all parties during Round 5 of the ECDSA TSS presigning protocol.
+type PresignRound5Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BigKX   []byte `protobuf:"bytes,1,opt,name=big_k_x,json=bigKX,proto3" json:"big_k_x,omitempty"`
+	BigKY   []byte `protobuf:"bytes,2,opt,name=big_k_y,json=bigKY,proto3" json:"big_k_y,omitempty"`
+	BigChiX []byte `protobuf:"bytes,3,opt,name=big_chi_x,json=bigChiX,proto3" json:"big_chi_x,omitempty"`
+	BigChiY []byte `protobuf:"bytes,4,opt,name=big_chi_y,json=bigChiY,proto3" json:"big_chi_y,omitempty"`
+}
+
+func (x *PresignRound5Message) Reset() {
+	*x = PresignRound5Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_presigning_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresignRound5Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignRound5Message) ProtoMessage() {}
+
+func (x *PresignRound5Message) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_presigning_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignRound5Message.ProtoReflect.Descriptor instead.
+func (*PresignRound5Message) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_presigning_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PresignRound5Message) GetBigKX() []byte {
+	if x != nil {
+		return x.BigKX
+	}
+	return nil
+}
+
+func (x *PresignRound5Message) GetBigKY() []byte {
+	if x != nil {
+		return x.BigKY
+	}
+	return nil
+}
+
+func (x *PresignRound5Message) GetBigChiX() []byte {
+	if x != nil {
+		return x.BigChiX
+	}
+	return nil
+}
+
+func (x *PresignRound5Message) GetBigChiY() []byte {
+	if x != nil {
+		return x.BigChiY
+	}
+	return nil
+}
+
+var File_protob_ecdsa_presigning_proto protoreflect.FileDescriptor
+
+var file_protob_ecdsa_presigning_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2f, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2d, 0x70,
+	0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x1f, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73, 0x73, 0x6c, 0x69, 0x62, 0x2e,
+	0x65, 0x63, 0x64, 0x73, 0x61, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x22, 0x51, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x31, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x31, 0x12, 0x0c, 0x0a, 0x01, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x63, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x0f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x41, 0x6c,
+	0x69, 0x63, 0x65, 0x22, 0x37, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x31, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x75, 0x0a, 0x14,
+	0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x02, 0x63, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x02, 0x63, 0x32, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x6f,
+	0x62, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x6f,
+	0x62, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x6f, 0x62, 0x5f, 0x77,
+	0x63, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x6f,
+	0x62, 0x57, 0x63, 0x22, 0x2c, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x33, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x22, 0x9c, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0c, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x22, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x41, 0x6c, 0x70,
+	0x68, 0x61, 0x58, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x5f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x59, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x5f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x54,
+	0x22, 0x7e, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x35, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x07, 0x62, 0x69, 0x67, 0x5f,
+	0x6b, 0x5f, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x69, 0x67, 0x4b, 0x58,
+	0x12, 0x16, 0x0a, 0x07, 0x62, 0x69, 0x67, 0x5f, 0x6b, 0x5f, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x62, 0x69, 0x67, 0x4b, 0x59, 0x12, 0x1a, 0x0a, 0x09, 0x62, 0x69, 0x67, 0x5f,
+	0x63, 0x68, 0x69, 0x5f, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x69, 0x67,
+	0x43, 0x68, 0x69, 0x58, 0x12, 0x1a, 0x0a, 0x09, 0x62, 0x69, 0x67, 0x5f, 0x63, 0x68, 0x69, 0x5f,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x69, 0x67, 0x43, 0x68, 0x69, 0x59,
+	0x42, 0x12, 0x5a, 0x10, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protob_ecdsa_presigning_proto_rawDescOnce sync.Once
+	file_protob_ecdsa_presigning_proto_rawDescData = file_protob_ecdsa_presigning_proto_rawDesc
+)
+
+func file_protob_ecdsa_presigning_proto_rawDescGZIP() []byte {
+	file_protob_ecdsa_presigning_proto_rawDescOnce.Do(func() {
+		file_protob_ecdsa_presigning_proto_rawDescData = protoimpl.X.CompressGZIP(file_protob_ecdsa_presigning_proto_rawDescData)
+	})
+	return file_protob_ecdsa_presigning_proto_rawDescData
+}
+
+var file_protob_ecdsa_presigning_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_protob_ecdsa_presigning_proto_goTypes = []interface{}{
+	(*PresignRound1Message1)(nil), // 0: binance.tsslib.ecdsa.presigning.PresignRound1Message1
+	(*PresignRound1Message2)(nil), // 1: binance.tsslib.ecdsa.presigning.PresignRound1Message2
+	(*PresignRound2Message)(nil),  // 2: binance.tsslib.ecdsa.presigning.PresignRound2Message
+	(*PresignRound3Message)(nil),  // 3: binance.tsslib.ecdsa.presigning.PresignRound3Message
+	(*PresignRound4Message)(nil),  // 4: binance.tsslib.ecdsa.presigning.PresignRound4Message
+	(*PresignRound5Message)(nil),  // 5: binance.tsslib.ecdsa.presigning.PresignRound5Message
+}
+var file_protob_ecdsa_presigning_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_protob_ecdsa_presigning_proto_init() }
+func file_protob_ecdsa_presigning_proto_init() {
+	if File_protob_ecdsa_presigning_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protob_ecdsa_presigning_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresignRound1Message1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_presigning_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresignRound1Message2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_presigning_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresignRound2Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_presigning_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresignRound3Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_presigning_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresignRound4Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_presigning_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresignRound5Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protob_ecdsa_presigning_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protob_ecdsa_presigning_proto_goTypes,
+		DependencyIndexes: file_protob_ecdsa_presigning_proto_depIdxs,
+		MessageInfos:      file_protob_ecdsa_presigning_proto_msgTypes,
+	}.Build()
+	File_protob_ecdsa_presigning_proto = out.File
+	file_protob_ecdsa_presigning_proto_rawDesc = nil
+	file_protob_ecdsa_presigning_proto_goTypes = nil
+	file_protob_ecdsa_presigning_proto_depIdxs = nil
+}
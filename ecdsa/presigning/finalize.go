@@ -0,0 +1,93 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"errors"
+	"math/big"
+
+	errors2 "github.com/pkg/errors"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+var (
+	zero = big.NewInt(0)
+)
+
+func (round *finalization) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 6
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	bigKj := make([]*crypto.ECPoint, len(Ps))
+	bigChij := make([]*crypto.ECPoint, len(Ps))
+	for j, Pj := range Ps {
+		round.ok[j] = true
+		r5msg := round.temp.presignRound5Messages[j].Content().(*PresignRound5Message)
+		bigKJ, bigChiJ, err := r5msg.UnmarshalPoints(round.Params().EC())
+		if err != nil {
+			return round.WrapError(errors2.Wrapf(err, "UnmarshalPoints"), Pj)
+		}
+		bigKj[j], bigChij[j] = bigKJ, bigChiJ
+	}
+
+	// sum(k_j * R) = k * k^-1 * G = G
+	sumK, sumChi := bigKj[0], bigChij[0]
+	for j := 1; j < len(Ps); j++ {
+		var err error
+		if sumK, err = sumK.Add(bigKj[j]); err != nil {
+			return round.WrapError(errors2.Wrapf(err, "sumK.Add(bigKj)"), Ps[j])
+		}
+		if sumChi, err = sumChi.Add(bigChij[j]); err != nil {
+			return round.WrapError(errors2.Wrapf(err, "sumChi.Add(bigChij)"), Ps[j])
+		}
+	}
+	g := crypto.NewECPointNoCurveCheck(round.Params().EC(), round.Params().EC().Params().Gx, round.Params().EC().Params().Gy)
+	if !sumK.Equals(g) {
+		return round.WrapError(errors.New("presignature consistency check failed: sum(k_j * R) != G"))
+	}
+	// sum(chi_j * R) = k * x * k^-1 * G = X
+	if !sumChi.Equals(round.key.ECDSAPub) {
+		return round.WrapError(errors.New("presignature consistency check failed: sum(chi_j * R) != X"))
+	}
+
+	round.data.R = round.temp.bigR
+	round.data.Ki = round.temp.k
+	round.data.ChiI = round.temp.chi
+	round.data.BigKj = bigKj
+	round.data.BigChij = bigChij
+	round.data.Ks = round.key.Ks
+	round.data.ECDSAPub = round.key.ECDSAPub
+
+	// clear the remaining secrets from temp memory, lint ignore
+	round.temp.w = zero
+	round.temp.gamma = zero
+
+	round.out.Finish(round.data)
+
+	return nil
+}
+
+func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *finalization) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *finalization) NextRound() tss.Round {
+	return nil // finished!
+}
@@ -0,0 +1,257 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	cmt "github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/crypto/mta"
+	"github.com/bnb-chain/tss-lib/v2/crypto/schnorr"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into ecdsa-presigning.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that presigning messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*PresignRound1Message1)(nil),
+		(*PresignRound1Message2)(nil),
+		(*PresignRound2Message)(nil),
+		(*PresignRound3Message)(nil),
+		(*PresignRound4Message)(nil),
+		(*PresignRound5Message)(nil),
+	}
+)
+
+// ----- //
+
+func NewPresignRound1Message1(
+	to, from *tss.PartyID,
+	c *big.Int,
+	proof *mta.RangeProofAlice,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	pfBz := proof.Bytes()
+	content := &PresignRound1Message1{
+		C:               c.Bytes(),
+		RangeProofAlice: pfBz[:],
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PresignRound1Message1) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.GetC()) &&
+		common.NonEmptyMultiBytes(m.GetRangeProofAlice(), mta.RangeProofAliceBytesParts)
+}
+
+func (m *PresignRound1Message1) UnmarshalC() *big.Int {
+	return new(big.Int).SetBytes(m.GetC())
+}
+
+func (m *PresignRound1Message1) UnmarshalRangeProofAlice() (*mta.RangeProofAlice, error) {
+	return mta.RangeProofAliceFromBytes(m.GetRangeProofAlice())
+}
+
+// ----- //
+
+func NewPresignRound1Message2(
+	from *tss.PartyID,
+	commitment cmt.HashCommitment,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &PresignRound1Message2{
+		Commitment: commitment.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PresignRound1Message2) ValidateBasic() bool {
+	return m.Commitment != nil &&
+		common.NonEmptyBytes(m.GetCommitment())
+}
+
+func (m *PresignRound1Message2) UnmarshalCommitment() *big.Int {
+	return new(big.Int).SetBytes(m.GetCommitment())
+}
+
+// ----- //
+
+func NewPresignRound2Message(
+	to, from *tss.PartyID,
+	c1Ji *big.Int,
+	pi1Ji *mta.ProofBob,
+	c2Ji *big.Int,
+	pi2Ji *mta.ProofBobWC,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	pfBob := pi1Ji.Bytes()
+	pfBobWC := pi2Ji.Bytes()
+	content := &PresignRound2Message{
+		C1:         c1Ji.Bytes(),
+		C2:         c2Ji.Bytes(),
+		ProofBob:   pfBob[:],
+		ProofBobWc: pfBobWC[:],
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PresignRound2Message) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.C1) &&
+		common.NonEmptyBytes(m.C2) &&
+		common.NonEmptyMultiBytes(m.ProofBob, mta.ProofBobBytesParts) &&
+		common.NonEmptyMultiBytes(m.ProofBobWc, mta.ProofBobWCBytesParts)
+}
+
+func (m *PresignRound2Message) UnmarshalProofBob() (*mta.ProofBob, error) {
+	return mta.ProofBobFromBytes(m.ProofBob)
+}
+
+func (m *PresignRound2Message) UnmarshalProofBobWC(ec elliptic.Curve) (*mta.ProofBobWC, error) {
+	return mta.ProofBobWCFromBytes(ec, m.ProofBobWc)
+}
+
+// ----- //
+
+func NewPresignRound3Message(
+	from *tss.PartyID,
+	delta *big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &PresignRound3Message{
+		Delta: delta.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PresignRound3Message) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.Delta)
+}
+
+func (m *PresignRound3Message) UnmarshalDelta() *big.Int {
+	return new(big.Int).SetBytes(m.GetDelta())
+}
+
+// ----- //
+
+func NewPresignRound4Message(
+	from *tss.PartyID,
+	deCommitment cmt.HashDeCommitment,
+	proof *schnorr.ZKProof,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	dcBzs := common.BigIntsToBytes(deCommitment)
+	content := &PresignRound4Message{
+		DeCommitment: dcBzs,
+		ProofAlphaX:  proof.Alpha.X().Bytes(),
+		ProofAlphaY:  proof.Alpha.Y().Bytes(),
+		ProofT:       proof.T.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PresignRound4Message) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyMultiBytes(m.DeCommitment, 3) &&
+		common.NonEmptyBytes(m.ProofAlphaX) &&
+		common.NonEmptyBytes(m.ProofAlphaY) &&
+		common.NonEmptyBytes(m.ProofT)
+}
+
+func (m *PresignRound4Message) UnmarshalDeCommitment() []*big.Int {
+	deComBzs := m.GetDeCommitment()
+	return cmt.NewHashDeCommitmentFromBytes(deComBzs)
+}
+
+func (m *PresignRound4Message) UnmarshalZKProof(ec elliptic.Curve) (*schnorr.ZKProof, error) {
+	point, err := crypto.NewECPoint(
+		ec,
+		new(big.Int).SetBytes(m.GetProofAlphaX()),
+		new(big.Int).SetBytes(m.GetProofAlphaY()))
+	if err != nil {
+		return nil, err
+	}
+	return &schnorr.ZKProof{
+		Alpha: point,
+		T:     new(big.Int).SetBytes(m.GetProofT()),
+	}, nil
+}
+
+// ----- //
+
+func NewPresignRound5Message(
+	from *tss.PartyID,
+	bigKi *crypto.ECPoint,
+	bigChiI *crypto.ECPoint,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &PresignRound5Message{
+		BigKX:   bigKi.X().Bytes(),
+		BigKY:   bigKi.Y().Bytes(),
+		BigChiX: bigChiI.X().Bytes(),
+		BigChiY: bigChiI.Y().Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PresignRound5Message) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.GetBigKX()) &&
+		common.NonEmptyBytes(m.GetBigKY()) &&
+		common.NonEmptyBytes(m.GetBigChiX()) &&
+		common.NonEmptyBytes(m.GetBigChiY())
+}
+
+func (m *PresignRound5Message) UnmarshalPoints(ec elliptic.Curve) (bigK, bigChi *crypto.ECPoint, err error) {
+	if bigK, err = crypto.NewECPoint(
+		ec,
+		new(big.Int).SetBytes(m.GetBigKX()),
+		new(big.Int).SetBytes(m.GetBigKY())); err != nil {
+		return nil, nil, err
+	}
+	if bigChi, err = crypto.NewECPoint(
+		ec,
+		new(big.Int).SetBytes(m.GetBigChiX()),
+		new(big.Int).SetBytes(m.GetBigChiY())); err != nil {
+		return nil, nil, err
+	}
+	return
+}
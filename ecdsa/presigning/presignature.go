@@ -0,0 +1,58 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+)
+
+// Presignature is the output of the presigning protocol. It is independent of the message and is consumed by
+// the online signing protocol in the `onlinesigning` package.
+//
+// ⚠️ A Presignature holds secret nonce material and MUST be used to sign at most one message.
+// Signing two different messages with the same Presignature leaks the secret key.
+type Presignature struct {
+	// the aggregated nonce point R = k^-1 * G
+	R *crypto.ECPoint
+
+	// secret; this party's additive shares of k and of k*x
+	Ki,
+	ChiI *big.Int
+
+	// public; k_j * R and chi_j * R for every signer, used to verify the online partial signatures
+	BigKj,
+	BigChij []*crypto.ECPoint
+
+	// the signer set (in sorted order) which produced this presignature and the public key it signs for
+	Ks       []*big.Int
+	ECDSAPub *crypto.ECPoint
+}
+
+// Validate performs a basic structural check of the presignature data.
+func (presig *Presignature) Validate() error {
+	if presig == nil {
+		return errors.New("presignature is nil")
+	}
+	if presig.Ki == nil || presig.ChiI == nil {
+		return errors.New("presignature is missing its secret shares")
+	}
+	if !presig.R.ValidateBasic() || !presig.ECDSAPub.ValidateBasic() {
+		return errors.New("presignature has an invalid R or ECDSAPub")
+	}
+	if len(presig.Ks) == 0 || len(presig.BigKj) != len(presig.Ks) || len(presig.BigChij) != len(presig.Ks) {
+		return errors.New("presignature has inconsistent signer data lengths")
+	}
+	for j := range presig.Ks {
+		if presig.Ks[j] == nil || !presig.BigKj[j].ValidateBasic() || !presig.BigChij[j].ValidateBasic() {
+			return errors.New("presignature has invalid signer data")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/crypto/mta"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/signing"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// round 1 represents round 1 of the presigning protocol, the message-independent part of signing.
+// rounds 1-4 follow the nonce generation and MtA phase of the GG18 ECDSA TSS spec (Gennaro, Goldfeder; 2018)
+func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *Presignature, temp *localTempData, out *tss.Outbox) tss.Round {
+	return &round1{
+		&base{params, key, data, temp, out, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+
+	round.number = 1
+	round.started = true
+	round.resetOK()
+	round.temp.ssidNonce = round.SSIDNonce()
+	ssid, err := round.getSSID()
+	if err != nil {
+		return round.WrapError(err)
+	}
+	round.temp.ssid = ssid
+
+	k := common.GetRandomPositiveIntWithRand(round.Rand(), round.Params().EC().Params().N)
+	gamma := common.GetRandomPositiveIntWithRand(round.Rand(), round.Params().EC().Params().N)
+
+	pointGamma := crypto.ScalarBaseMult(round.Params().EC(), gamma)
+	cmt := commitments.NewHashCommitmentWithRand(round.Rand(), pointGamma.X(), pointGamma.Y())
+	round.temp.k = k
+	round.temp.gamma = gamma
+	round.temp.pointGamma = pointGamma
+	round.temp.deCommit = cmt.D
+
+	i := round.PartyID().Index
+	round.ok[i] = true
+
+	for j, Pj := range round.Parties().IDs() {
+		if j == i {
+			continue
+		}
+		cA, pi, err := mta.AliceInitWithRand(round.Rand(), round.Params().EC(), round.key.PaillierPKs[i], k, round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j])
+		if err != nil {
+			return round.WrapError(fmt.Errorf("failed to init mta: %v", err))
+		}
+		r1msg1 := NewPresignRound1Message1(Pj, round.PartyID(), cA, pi)
+		round.temp.cis[j] = cA
+		round.out.Send(r1msg1)
+	}
+
+	r1msg2 := NewPresignRound1Message2(round.PartyID(), cmt.C)
+	round.temp.presignRound1Message2s[i] = r1msg2
+	round.out.Send(r1msg2)
+
+	return nil
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg1 := range round.temp.presignRound1Message1s {
+		if round.ok[j] {
+			continue
+		}
+		if msg1 == nil || !round.CanAccept(msg1) {
+			return false, nil
+		}
+		msg2 := round.temp.presignRound1Message2s[j]
+		if msg2 == nil || !round.CanAccept(msg2) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*PresignRound1Message1); ok {
+		return !msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*PresignRound1Message2); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
+
+// ----- //
+
+// helper to call into signing.PrepareForSigning()
+func (round *round1) prepare() error {
+	i := round.PartyID().Index
+
+	xi := round.key.Xi
+	ks := round.key.Ks
+	bigXs := round.key.BigXj
+
+	if round.Threshold()+1 > len(ks) {
+		return fmt.Errorf("t+1=%d is not satisfied by the key count of %d", round.Threshold()+1, len(ks))
+	}
+	wi, bigWs := signing.PrepareForSigning(round.Params().EC(), i, len(ks), xi, ks, bigXs)
+
+	round.temp.w = wi
+	round.temp.bigWs = bigWs
+	return nil
+}
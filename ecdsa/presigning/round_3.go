@@ -0,0 +1,160 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	errorspkg "github.com/pkg/errors"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto/mta"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	var alphas = make([]*big.Int, len(round.Parties().IDs()))
+	var us = make([]*big.Int, len(round.Parties().IDs()))
+
+	i := round.PartyID().Index
+
+	errChs := make(chan *tss.Error, (len(round.Parties().IDs())-1)*2)
+	wg := sync.WaitGroup{}
+	wg.Add((len(round.Parties().IDs()) - 1) * 2)
+	for j, Pj := range round.Parties().IDs() {
+		if j == i {
+			continue
+		}
+		ContextJ := append(round.temp.ssid, new(big.Int).SetUint64(uint64(j)).Bytes()...)
+		// Alice_end
+		go func(j int, Pj *tss.PartyID) {
+			defer wg.Done()
+			if round.Context().Err() != nil {
+				return
+			}
+			r2msg := round.temp.presignRound2Messages[j].Content().(*PresignRound2Message)
+			proofBob, err := r2msg.UnmarshalProofBob()
+			if err != nil {
+				errChs <- round.WrapError(errorspkg.Wrapf(err, "UnmarshalProofBob failed"), Pj)
+				return
+			}
+			alphaIj, err := mta.AliceEnd(
+				ContextJ,
+				round.Params().EC(),
+				round.key.PaillierPKs[i],
+				proofBob,
+				round.key.H1j[i],
+				round.key.H2j[i],
+				round.temp.cis[j],
+				new(big.Int).SetBytes(r2msg.GetC1()),
+				round.key.NTildej[i],
+				round.key.PaillierSK)
+			alphas[j] = alphaIj
+			if err != nil {
+				errChs <- round.WrapError(err, Pj)
+			}
+		}(j, Pj)
+		// Alice_end_wc
+		go func(j int, Pj *tss.PartyID) {
+			defer wg.Done()
+			if round.Context().Err() != nil {
+				return
+			}
+			r2msg := round.temp.presignRound2Messages[j].Content().(*PresignRound2Message)
+			proofBobWC, err := r2msg.UnmarshalProofBobWC(round.Parameters.EC())
+			if err != nil {
+				errChs <- round.WrapError(errorspkg.Wrapf(err, "UnmarshalProofBobWC failed"), Pj)
+				return
+			}
+			uIj, err := mta.AliceEndWC(
+				ContextJ,
+				round.Params().EC(),
+				round.key.PaillierPKs[i],
+				proofBobWC,
+				round.temp.bigWs[j],
+				round.temp.cis[j],
+				new(big.Int).SetBytes(r2msg.GetC2()),
+				round.key.NTildej[i],
+				round.key.H1j[i],
+				round.key.H2j[i],
+				round.key.PaillierSK)
+			us[j] = uIj
+			if err != nil {
+				errChs <- round.WrapError(err, Pj)
+			}
+		}(j, Pj)
+	}
+
+	// consume error channels; wait for goroutines
+	wg.Wait()
+	close(errChs)
+	// the goroutines skip their work once the party has been aborted
+	if err := round.Context().Err(); err != nil {
+		return round.WrapError(err)
+	}
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
+	for err := range errChs {
+		culprits = append(culprits, err.Culprits()...)
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(errors.New("failed to calculate Alice_end or Alice_end_wc"), culprits...)
+	}
+
+	modN := common.ModInt(round.Params().EC().Params().N)
+	delta := modN.Mul(round.temp.k, round.temp.gamma)
+	chi := modN.Mul(round.temp.k, round.temp.w)
+
+	for j := range round.Parties().IDs() {
+		if j == round.PartyID().Index {
+			continue
+		}
+		delta = modN.Add(delta, alphas[j].Add(alphas[j], round.temp.betas[j]))
+		chi = modN.Add(chi, us[j].Add(us[j], round.temp.vs[j]))
+	}
+
+	round.temp.delta = delta
+	round.temp.chi = chi
+	r3msg := NewPresignRound3Message(round.PartyID(), delta)
+	round.temp.presignRound3Messages[round.PartyID().Index] = r3msg
+	round.out.Send(r3msg)
+
+	return nil
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.presignRound3Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*PresignRound3Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round3) NextRound() tss.Round {
+	round.started = false
+	return &round4{round}
+}
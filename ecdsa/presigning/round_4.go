@@ -0,0 +1,82 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"errors"
+	"math/big"
+
+	errors2 "github.com/pkg/errors"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto/schnorr"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func (round *round4) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 4
+	round.started = true
+	round.resetOK()
+
+	deltaInverse := new(big.Int).Set(round.temp.delta)
+
+	modN := common.ModInt(round.Params().EC().Params().N)
+
+	for j := range round.Parties().IDs() {
+		if j == round.PartyID().Index {
+			continue
+		}
+		r3msg := round.temp.presignRound3Messages[j].Content().(*PresignRound3Message)
+		deltaInverse = modN.Add(deltaInverse, r3msg.UnmarshalDelta())
+	}
+
+	// compute the multiplicative inverse delta mod q
+	if deltaInverse.Sign() == 0 {
+		return round.WrapError(errors.New("delta sums to zero"))
+	}
+	deltaInverse = modN.ModInverse(deltaInverse)
+	i := round.PartyID().Index
+	ContextI := append(round.temp.ssid, new(big.Int).SetUint64(uint64(i)).Bytes()...)
+	piGamma, err := schnorr.NewZKProofWithRand(round.Rand(), ContextI, round.temp.gamma, round.temp.pointGamma)
+	if err != nil {
+		return round.WrapError(errors2.Wrapf(err, "NewZKProof(gamma, bigGamma)"))
+	}
+	round.temp.deltaInverse = deltaInverse
+	r4msg := NewPresignRound4Message(round.PartyID(), round.temp.deCommit, piGamma)
+	round.temp.presignRound4Messages[round.PartyID().Index] = r4msg
+	round.out.Send(r4msg)
+
+	return nil
+}
+
+func (round *round4) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.presignRound4Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round4) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*PresignRound4Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round4) NextRound() tss.Round {
+	round.started = false
+	return &round5{round}
+}
@@ -0,0 +1,176 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	cmt "github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/crypto/vss"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// Implements Party
+// Implements Stringer
+var _ tss.Party = (*LocalParty)(nil)
+var _ fmt.Stringer = (*LocalParty)(nil)
+
+type (
+	LocalParty struct {
+		*tss.BaseParty
+		params *tss.Parameters
+
+		temp localTempData
+		save *keygen.LocalPartySaveData
+	}
+
+	localMessageStore struct {
+		refreshRound1Messages,
+		refreshRound2Message1s,
+		refreshRound2Message2s []tss.ParsedMessage
+	}
+
+	localTempData struct {
+		localMessageStore
+
+		// the number of parties holding the key before the refresh, which must all take part
+		committeeSize int
+		// new Paillier and NTilde parameters, set when this party rotates them
+		preParams *keygen.LocalPreParams
+
+		// temp data (thrown away after refresh) / round 1
+		vs            vss.Vs
+		shares        vss.Shares
+		deCommitPolyG cmt.HashDeCommitment
+
+		// round 2
+		KGCs    []*big.Int
+		rotated []bool
+
+		ssid      []byte
+		ssidNonce *big.Int
+	}
+)
+
+// NewLocalParty returns a party which re-randomizes the key shares of `key` in place. Every party holding the key must
+// take part with the same PartyIDs that it had at keygen; the public key and Ks are unchanged. When `optionalPreParams`
+// is provided this party also replaces its Paillier key and NTilde, h1, h2 with the given pre-params.
+//
+// The input key data is not modified; persist the save data received through `end` in place of it.
+func NewLocalParty(
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- *keygen.LocalPartySaveData,
+	optionalPreParams ...keygen.LocalPreParams,
+) tss.Party {
+	partyCount := len(params.Parties().IDs())
+	save := keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs())
+	p := &LocalParty{
+		BaseParty: tss.NewBaseParty(params, out, func(result interface{}) { end <- result.(*keygen.LocalPartySaveData) }),
+		params:    params,
+		temp:      localTempData{},
+		save:      &save,
+	}
+	if 0 < len(optionalPreParams) {
+		if 1 < len(optionalPreParams) {
+			panic(errors.New("refresh.NewLocalParty expected 0 or 1 item in `optionalPreParams`"))
+		}
+		if !optionalPreParams[0].ValidateWithProof() {
+			panic(errors.New("`optionalPreParams` failed to validate; it might have been generated with an older version of tss-lib"))
+		}
+		p.temp.preParams = &optionalPreParams[0]
+	}
+	// msgs init
+	p.temp.refreshRound1Messages = make([]tss.ParsedMessage, partyCount)
+	p.temp.refreshRound2Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.refreshRound2Message2s = make([]tss.ParsedMessage, partyCount)
+	// temp data init
+	p.temp.committeeSize = len(key.Ks)
+	p.temp.KGCs = make([]*big.Int, partyCount)
+	p.temp.rotated = make([]bool, partyCount)
+	return p
+}
+
+func (p *LocalParty) FirstRound() tss.Round {
+	return newRound1(p.params, p.save, &p.temp, p.Outbox())
+}
+
+func (p *LocalParty) Start() *tss.Error {
+	return tss.BaseStart(p, TaskName, func(round tss.Round) *tss.Error {
+		if _, ok := round.(*round1); !ok {
+			return round.WrapError(errors.New("unable to Start(). party is in an unexpected round"))
+		}
+		return nil
+	})
+}
+
+func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
+	opened, openErr := p.params.OpenMessage(msg)
+	if openErr != nil {
+		return false, p.WrapError(openErr)
+	}
+	return tss.BaseUpdate(p, opened, TaskName)
+}
+
+func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := tss.ParseSessionWireMessage(wireBytes, from, isBroadcast, p.params.SessionID())
+	if err != nil {
+		return false, p.WrapError(err)
+	}
+	return p.Update(msg)
+}
+
+func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	if ok, err := p.BaseParty.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	// check that the message's "from index" will fit into the array
+	if maxFromIdx := len(p.params.Parties().IDs()) - 1; maxFromIdx < msg.GetFrom().Index {
+		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+	}
+	if err := p.params.ValidateSessionID(msg); err != nil {
+		return false, p.WrapError(err, msg.GetFrom())
+	}
+	return true, nil
+}
+
+func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	// ValidateBasic is cheap; double-check the message here in case the public StoreMessage was called externally
+	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	fromPIdx := msg.GetFrom().Index
+
+	// switch/case is necessary to store any messages beyond current round
+	// replays and equivocations are caught by tss.BaseUpdate before this point. we expect the caller to apply spoofing protection.
+	switch msg.Content().(type) {
+	case *RefreshRound1Message:
+		p.temp.refreshRound1Messages[fromPIdx] = msg
+	case *RefreshRound2Message1:
+		p.temp.refreshRound2Message1s[fromPIdx] = msg
+	case *RefreshRound2Message2:
+		p.temp.refreshRound2Message2s[fromPIdx] = msg
+	default: // unrecognised message, just ignore!
+		p.params.Logger(TaskName, 0).Warn("unrecognised message ignored", common.StringField("msg", msg.String()))
+		return false, nil
+	}
+	return true, nil
+}
+
+func (p *LocalParty) PartyID() *tss.PartyID {
+	return p.params.PartyID()
+}
+
+func (p *LocalParty) String() string {
+	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
+}
@@ -0,0 +1,204 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/signing"
+	"github.com/bnb-chain/tss-lib/v2/test"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+// runParties routes messages between the given parties until `done` is closed
+func runParties(t *testing.T, parties []tss.Party, outCh chan tss.Message, errCh chan *tss.Error, done <-chan struct{}) {
+	for _, P := range parties {
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	for {
+		select {
+		case <-done:
+			// the parties are done once the updates that ended them return
+			for _, P := range parties {
+				assert.Empty(t, P.WaitingFor(), "a finished party must not wait for anyone")
+			}
+			return
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go test.SharedPartyUpdater(P, msg, errCh)
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		}
+	}
+}
+
+func refresh(t *testing.T, keys []keygen.LocalPartySaveData, pIDs tss.SortedPartyIDs, preParams []keygen.LocalPreParams) []keygen.LocalPartySaveData {
+	p2pCtx := tss.NewPeerContext(pIDs)
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan *keygen.LocalPartySaveData, len(pIDs))
+
+	parties := make([]tss.Party, 0, len(pIDs))
+	for i := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+		var P tss.Party
+		if preParams != nil {
+			P = NewLocalParty(params, keys[i], outCh, endCh, preParams[i])
+		} else {
+			P = NewLocalParty(params, keys[i], outCh, endCh)
+		}
+		parties = append(parties, P)
+	}
+	refreshed := make([]keygen.LocalPartySaveData, len(pIDs))
+	done := make(chan struct{})
+	go func() {
+		for range pIDs {
+			save := <-endCh
+			index, err := save.OriginalIndex()
+			assert.NoErrorf(t, err, "should not be an error getting a party's index from save data")
+			refreshed[index] = *save
+		}
+		close(done)
+	}()
+	runParties(t, parties, outCh, errCh, done)
+	return refreshed
+}
+
+func assertRefreshed(t *testing.T, keys, refreshed []keygen.LocalPartySaveData) {
+	for i, save := range refreshed {
+		assert.True(t, keys[i].ECDSAPub.Equals(save.ECDSAPub), "the public key must not change")
+		assert.Equal(t, keys[i].Ks, save.Ks, "the party keys must not change")
+		assert.NotEqual(t, 0, keys[i].Xi.Cmp(save.Xi), "the share must change")
+		assert.True(t, crypto.ScalarBaseMult(tss.S256(), save.Xi).Equals(save.BigXj[i]))
+		for j := range refreshed {
+			assert.True(t, save.BigXj[j].Equals(refreshed[0].BigXj[j]), "all parties must agree on the public shares")
+		}
+	}
+	// the refreshed shares must still combine to the same secret
+	modN := common.ModInt(tss.S256().Params().N)
+	ks := refreshed[0].Ks[:testThreshold+1]
+	secret := big.NewInt(0)
+	for i := range ks {
+		wi, _ := signing.PrepareForSigning(tss.S256(), i, len(ks), refreshed[i].Xi, ks, refreshed[0].BigXj[:testThreshold+1])
+		secret = modN.Add(secret, wi)
+	}
+	assert.True(t, crypto.ScalarBaseMult(tss.S256(), secret).Equals(refreshed[0].ECDSAPub))
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	refreshed := refresh(t, keys, pIDs, nil)
+	assertRefreshed(t, keys, refreshed)
+	for i, save := range refreshed {
+		assert.Equal(t, keys[i].NTildej, save.NTildej, "NTilde must not change without rotation")
+		assert.Equal(t, keys[i].PaillierPKs, save.PaillierPKs, "paillier keys must not change without rotation")
+	}
+}
+
+func TestE2EConcurrentWithRotation(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// re-use the pre-params of the next fixture so that every party gets new, still unique, paillier keys and NTilde
+	preParams := make([]keygen.LocalPreParams, len(keys))
+	for i := range keys {
+		preParams[i] = keys[(i+1)%len(keys)].LocalPreParams
+	}
+	refreshed := refresh(t, keys, pIDs, preParams)
+	assertRefreshed(t, keys, refreshed)
+	for i, save := range refreshed {
+		for j := range refreshed {
+			assert.Equal(t, preParams[j].NTildei, save.NTildej[j])
+			assert.Equal(t, preParams[j].PaillierSK.N, save.PaillierPKs[j].N)
+		}
+		assert.Equal(t, preParams[i].PaillierSK, save.PaillierSK)
+	}
+
+	// PHASE: signing with the refreshed keys
+	signKeys, signPIDs := refreshed[:testThreshold+1], pIDs[:testThreshold+1]
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+	msg := big.NewInt(42)
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		parties = append(parties, signing.NewLocalParty(msg, params, signKeys[i], outCh, endCh))
+	}
+	var sig *common.SignatureData
+	done := make(chan struct{})
+	go func() {
+		for range signPIDs {
+			sig = <-endCh
+		}
+		close(done)
+	}()
+	runParties(t, parties, outCh, errCh, done)
+
+	pk := ecdsa.PublicKey{
+		Curve: tss.EC(),
+		X:     keys[0].ECDSAPub.X(),
+		Y:     keys[0].ECDSAPub.Y(),
+	}
+	ok := ecdsa.Verify(&pk, msg.Bytes(), new(big.Int).SetBytes(sig.R), new(big.Int).SetBytes(sig.S))
+	assert.True(t, ok, "ecdsa verify must pass")
+}
+
+func TestInvalidPreParams(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// complete pre-params that do not hold together are rejected when the party starts, not when it is built
+	preParams := keys[1].LocalPreParams
+	preParams.H2i = preParams.H1i
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), testThreshold)
+	P := NewLocalParty(params, keys[0], make(chan tss.Message, len(pIDs)), make(chan *keygen.LocalPartySaveData, 1), preParams)
+	if tssErr := P.Start(); assert.NotNil(t, tssErr) {
+		assert.Contains(t, tssErr.Error(), "failed to validate")
+	}
+}
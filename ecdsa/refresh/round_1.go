@@ -0,0 +1,122 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	cmts "github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/crypto/dlnproof"
+	"github.com/bnb-chain/tss-lib/v2/crypto/vss"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// round 1 represents round 1 of the key refresh protocol, in which each party deals a Shamir sharing of zero
+func newRound1(params *tss.Parameters, save *keygen.LocalPartySaveData, temp *localTempData, out *tss.Outbox) tss.Round {
+	return &round1{
+		&base{params, save, temp, out, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	// every share of the key must be refreshed, otherwise the stale shares could no longer be combined with new ones
+	if round.PartyCount() != round.temp.committeeSize {
+		return round.WrapError(fmt.Errorf("all %d parties holding the key must take part in a refresh, got %d",
+			round.temp.committeeSize, round.PartyCount()))
+	}
+	if round.save.ShareID == nil || round.save.ShareID.Cmp(Pi.KeyInt()) != 0 {
+		return round.WrapError(errors.New("the key data does not belong to this party"), Pi)
+	}
+
+	round.temp.ssidNonce = round.SSIDNonce()
+	ssid, err := round.getSSID()
+	if err != nil {
+		return round.WrapError(errors.New("failed to generate ssid"))
+	}
+	round.temp.ssid = ssid
+
+	// 1. compute the vss shares of zero
+	ids := round.Parties().IDs().Keys()
+	vs, shares, err := vss.CreateZeroSharing(round.Rand(), round.Params().EC(), round.Threshold(), ids)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	round.temp.vs = vs
+	round.temp.shares = shares
+
+	// 2. make commitment -> (C, D)
+	pGFlat, err := crypto.FlattenECPoints(vs)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	cmt := cmts.NewHashCommitmentWithRand(round.Rand(), pGFlat...)
+	round.temp.deCommitPolyG = cmt.D
+
+	// 3. BROADCAST commitment, and the new paillier pk, NTilde, h1, h2 with proofs when rotating them
+	var msg tss.ParsedMessage
+	if preParams := round.temp.preParams; preParams != nil {
+		if err := preParams.ValidateFull(); err != nil {
+			return round.WrapError(fmt.Errorf("`optionalPreParams` failed to validate: %v", err))
+		}
+		round.save.LocalPreParams = *preParams
+		round.save.NTildej[i] = preParams.NTildei
+		round.save.H1j[i], round.save.H2j[i] = preParams.H1i, preParams.H2i
+		round.save.PaillierPKs[i] = &preParams.PaillierSK.PublicKey
+		round.temp.rotated[i] = true
+
+		dlnProof1 := dlnproof.NewDLNProofWithRand(round.Rand(), preParams.H1i, preParams.H2i, preParams.Alpha, preParams.P, preParams.Q, preParams.NTildei)
+		dlnProof2 := dlnproof.NewDLNProofWithRand(round.Rand(), preParams.H2i, preParams.H1i, preParams.Beta, preParams.P, preParams.Q, preParams.NTildei)
+		msg, err = NewRefreshRound1Message(
+			Pi, cmt.C, &preParams.PaillierSK.PublicKey, preParams.NTildei, preParams.H1i, preParams.H2i, dlnProof1, dlnProof2)
+	} else {
+		msg, err = NewRefreshRound1Message(Pi, cmt.C, nil, nil, nil, nil, nil, nil)
+	}
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	round.temp.refreshRound1Messages[i] = msg
+	round.out.Send(msg)
+	return nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*RefreshRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.refreshRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		// proof checks are in round 2
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
@@ -0,0 +1,182 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto/facproof"
+	"github.com/bnb-chain/tss-lib/v2/crypto/modproof"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	paillierBitsLen = 2048
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	dlnVerifier := keygen.NewDlnProofVerifierWithContext(round.Context(), round.Concurrency())
+
+	i := round.PartyID().Index
+
+	// 1. verify the new NTilde, h1, h2 of the parties that rotate them
+	dlnProof1FailCulprits := make([]*tss.PartyID, len(round.temp.refreshRound1Messages))
+	dlnProof2FailCulprits := make([]*tss.PartyID, len(round.temp.refreshRound1Messages))
+	wg := new(sync.WaitGroup)
+	for j, msg := range round.temp.refreshRound1Messages {
+		r1msg := msg.Content().(*RefreshRound1Message)
+		round.temp.KGCs[j] = r1msg.UnmarshalCommitment()
+		if j == i || !r1msg.RotatesKeys() {
+			continue
+		}
+		H1j, H2j, NTildej, paillierPKj :=
+			r1msg.UnmarshalH1(),
+			r1msg.UnmarshalH2(),
+			r1msg.UnmarshalNTilde(),
+			r1msg.UnmarshalPaillierPK()
+		if paillierPKj.N.BitLen() != paillierBitsLen {
+			return round.WrapError(errors.New("got paillier modulus with insufficient bits for this party"), msg.GetFrom())
+		}
+		if H1j.Cmp(H2j) == 0 {
+			return round.WrapError(errors.New("h1j and h2j were equal for this party"), msg.GetFrom())
+		}
+		if NTildej.BitLen() != paillierBitsLen {
+			return round.WrapError(errors.New("got NTildej with insufficient bits for this party"), msg.GetFrom())
+		}
+
+		wg.Add(2)
+		_j := j
+		_msg := msg
+
+		dlnVerifier.VerifyDLNProof1(r1msg, H1j, H2j, NTildej, func(isValid bool) {
+			if !isValid {
+				dlnProof1FailCulprits[_j] = _msg.GetFrom()
+			}
+			wg.Done()
+		})
+		dlnVerifier.VerifyDLNProof2(r1msg, H2j, H1j, NTildej, func(isValid bool) {
+			if !isValid {
+				dlnProof2FailCulprits[_j] = _msg.GetFrom()
+			}
+			wg.Done()
+		})
+	}
+	wg.Wait()
+	if err := round.Context().Err(); err != nil {
+		return round.WrapError(err)
+	}
+	for _, culprit := range append(dlnProof1FailCulprits, dlnProof2FailCulprits...) {
+		if culprit != nil {
+			return round.WrapError(errors.New("dln proof verification failed"), culprit)
+		}
+	}
+	// save the new NTilde_j, h1_j, h2_j, ...
+	for j, msg := range round.temp.refreshRound1Messages {
+		r1msg := msg.Content().(*RefreshRound1Message)
+		if j == i || !r1msg.RotatesKeys() {
+			continue
+		}
+		round.save.PaillierPKs[j] = r1msg.UnmarshalPaillierPK()
+		round.save.NTildej[j] = r1msg.UnmarshalNTilde()
+		round.save.H1j[j], round.save.H2j[j] = r1msg.UnmarshalH1(), r1msg.UnmarshalH2()
+		round.temp.rotated[j] = true
+	}
+	// 2. ensure uniqueness of h1j, h2j across the committee after the rotation
+	h1H2Map := make(map[string]struct{}, len(round.save.H1j)*2)
+	for j, Pj := range round.Parties().IDs() {
+		h1JHex, h2JHex := hex.EncodeToString(round.save.H1j[j].Bytes()), hex.EncodeToString(round.save.H2j[j].Bytes())
+		if _, found := h1H2Map[h1JHex]; found {
+			return round.WrapError(errors.New("this h1j was already used by another party"), Pj)
+		}
+		if _, found := h1H2Map[h2JHex]; found {
+			return round.WrapError(errors.New("this h2j was already used by another party"), Pj)
+		}
+		h1H2Map[h1JHex], h1H2Map[h2JHex] = struct{}{}, struct{}{}
+	}
+
+	// 3. p2p send share ij to Pj, proving that a new paillier modulus has no small factors
+	rotating := round.temp.rotated[i]
+	ContextI := append(round.temp.ssid, big.NewInt(int64(i)).Bytes()...)
+	for j, Pj := range round.Parties().IDs() {
+		var facProof *facproof.ProofFac
+		if rotating && !round.Params().NoProofFac() {
+			var err error
+			facProof, err = facproof.NewProofWithRand(
+				round.Rand(), ContextI, round.EC(), round.save.PaillierSK.N, round.save.NTildej[j],
+				round.save.H1j[j], round.save.H2j[j], round.save.PaillierSK.P, round.save.PaillierSK.Q)
+			if err != nil {
+				return round.WrapError(err, round.PartyID())
+			}
+		}
+		r2msg1 := NewRefreshRound2Message1(Pj, round.PartyID(), round.temp.shares[j].Share, facProof)
+		// do not send to this Pj, but store for round 3
+		if j == i {
+			round.temp.refreshRound2Message1s[j] = r2msg1
+			continue
+		}
+		round.out.Send(r2msg1)
+	}
+
+	// 4. BROADCAST de-commitments of the zero poly*G
+	var modProof *modproof.ProofMod
+	if rotating && !round.Params().NoProofMod() {
+		var err error
+		modProof, err = modproof.NewProofWithRand(
+			round.Rand(), ContextI, round.save.PaillierSK.N,
+			round.save.PaillierSK.P, round.save.PaillierSK.Q)
+		if err != nil {
+			return round.WrapError(err, round.PartyID())
+		}
+	}
+	r2msg2 := NewRefreshRound2Message2(round.PartyID(), round.temp.deCommitPolyG, modProof)
+	round.temp.refreshRound2Message2s[i] = r2msg2
+	round.out.Send(r2msg2)
+	return nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*RefreshRound2Message1); ok {
+		return !msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*RefreshRound2Message2); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.refreshRound2Message1s {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		msg2 := round.temp.refreshRound2Message2s[j]
+		if msg2 == nil || !round.CanAccept(msg2) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	return &round3{round}
+}
@@ -0,0 +1,159 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/hashicorp/go-multierror"
+	errors2 "github.com/pkg/errors"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/crypto/vss"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	PIdx := round.PartyID().Index
+	ec := round.Params().EC()
+
+	// 1-5. verify the de-commitments, zero shares and paillier proofs of every Pj
+	pjVs := make([]vss.Vs, len(Ps))
+	pjVs[PIdx] = round.temp.vs
+	var multiErr error
+	culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+	for j, Pj := range Ps {
+		if j == PIdx {
+			continue
+		}
+		vs, err := round.verifyParty(j)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+			culprits = append(culprits, Pj)
+			continue
+		}
+		pjVs[j] = vs
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(multiErr, culprits...)
+	}
+
+	// 6. calculate the new xi = xi + sum(f_j(i))
+	modQ := common.ModInt(ec.Params().N)
+	xi := round.save.Xi
+	for j := range Ps {
+		r2msg1 := round.temp.refreshRound2Message1s[j].Content().(*RefreshRound2Message1)
+		xi = modQ.Add(xi, r2msg1.UnmarshalShare())
+	}
+
+	// 7. combine the commitments of all of the zero sharings
+	Vc := make(vss.Vs, round.Threshold())
+	for c := range Vc {
+		Vc[c] = pjVs[0][c]
+		for j := 1; j < len(Ps); j++ {
+			var err error
+			if Vc[c], err = Vc[c].Add(pjVs[j][c]); err != nil {
+				return round.WrapError(errors2.Wrapf(err, "adding PjVs[c] to Vc[c]"), Ps[j])
+			}
+		}
+	}
+
+	// 8. compute the new Xj = Xj + f(j)*G for each Pj
+	bigXj := make([]*crypto.ECPoint, len(Ps))
+	for j, Pj := range Ps {
+		deltaXj, err := vss.EvaluateZeroCommitments(ec, Vc, Pj.KeyInt())
+		if err != nil {
+			return round.WrapError(errors2.Wrapf(err, "EvaluateZeroCommitments"), Pj)
+		}
+		if bigXj[j], err = round.save.BigXj[j].Add(deltaXj); err != nil {
+			return round.WrapError(errors2.Wrapf(err, "adding f(j)*G to Xj"), Pj)
+		}
+	}
+	if !crypto.ScalarBaseMult(ec, xi).Equals(bigXj[PIdx]) {
+		return round.WrapError(errors.New("the refreshed share does not match its public share"))
+	}
+
+	// SAVE the refreshed share; the public key and Ks are unchanged
+	round.save.Xi = xi
+	round.save.BigXj = bigXj
+
+	for j := range round.ok {
+		round.ok[j] = true
+	}
+	round.out.Finish(round.save)
+	return nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *round3) NextRound() tss.Round {
+	return nil // finished!
+}
+
+// ----- //
+
+// verifyParty checks the de-commitment and zero share of Pj and, when Pj rotated its keys, its paillier proofs
+func (round *round3) verifyParty(j int) (vss.Vs, error) {
+	ec := round.Params().EC()
+	ContextJ := common.AppendBigIntToBytesSlice(round.temp.ssid, big.NewInt(int64(j)))
+
+	r2msg2 := round.temp.refreshRound2Message2s[j].Content().(*RefreshRound2Message2)
+	cmtDeCmt := commitments.HashCommitDecommit{C: round.temp.KGCs[j], D: r2msg2.UnmarshalDeCommitment()}
+	ok, flatPolyGs := cmtDeCmt.DeCommit()
+	if !ok || flatPolyGs == nil {
+		return nil, errors.New("de-commitment verify failed")
+	}
+	PjVs, err := crypto.UnFlattenECPoints(ec, flatPolyGs)
+	if err != nil {
+		return nil, err
+	}
+	r2msg1 := round.temp.refreshRound2Message1s[j].Content().(*RefreshRound2Message1)
+	PjShare := vss.Share{
+		Threshold: round.Threshold(),
+		ID:        round.PartyID().KeyInt(),
+		Share:     r2msg1.UnmarshalShare(),
+	}
+	if ok = PjShare.VerifyZeroShare(ec, round.Threshold(), PjVs); !ok {
+		return nil, errors.New("vss verify failed")
+	}
+	if !round.temp.rotated[j] {
+		return PjVs, nil
+	}
+	if !round.Params().NoProofMod() {
+		modProof, err := r2msg2.UnmarshalModProof()
+		if err != nil || !modProof.Verify(ContextJ, round.save.PaillierPKs[j].N) {
+			return nil, errors.New("modProof verify failed")
+		}
+	}
+	if !round.Params().NoProofFac() {
+		facProof, err := r2msg1.UnmarshalFacProof()
+		if err != nil || !facProof.Verify(ContextJ, ec, round.save.PaillierPKs[j].N, round.save.NTildei,
+			round.save.H1i, round.save.H2i) {
+			return nil, errors.New("facProof verify failed")
+		}
+	}
+	return PjVs, nil
+}
@@ -0,0 +1,167 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package repair
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// Implements Party
+// Implements Stringer
+var _ tss.Party = (*LocalParty)(nil)
+var _ fmt.Stringer = (*LocalParty)(nil)
+
+type (
+	LocalParty struct {
+		*tss.BaseParty
+		params *tss.Parameters
+
+		temp localTempData
+		save *keygen.LocalPartySaveData
+	}
+
+	localMessageStore struct {
+		repairRound1Message1s,
+		repairRound1Message2s,
+		repairRound2Messages []tss.ParsedMessage
+	}
+
+	localTempData struct {
+		localMessageStore
+
+		// the index of the party whose share is repaired; the other parties are the helpers
+		lostIdx int
+		// the public shares Xj and party keys of the parties, in party order
+		bigXs []*crypto.ECPoint
+		ks    []*big.Int
+
+		// round 2
+		commitments [][]*crypto.ECPoint
+	}
+)
+
+// NewLocalParty returns a party of the share repair protocol, which recomputes the lost share of `lost` at its
+// existing ShareID. The parties are `lost` and at least t+1 helpers holding their shares of the same key; only `lost`
+// learns the repaired share, and the shares of the helpers and the public key are unchanged.
+//
+// `lost` passes its save data with Xi missing; it must still hold the rest of it, including its pre-params. The save
+// data received through `end` is the input key data with the repaired Xi for `lost` and unchanged for the helpers.
+func NewLocalParty(
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	lost *tss.PartyID,
+	out chan<- tss.Message,
+	end chan<- *keygen.LocalPartySaveData,
+) tss.Party {
+	partyCount := len(params.Parties().IDs())
+	p := &LocalParty{
+		BaseParty: tss.NewBaseParty(params, out, func(result interface{}) { end <- result.(*keygen.LocalPartySaveData) }),
+		params:    params,
+		temp:      localTempData{},
+		save:      &key,
+	}
+	// msgs init
+	p.temp.repairRound1Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.repairRound1Message2s = make([]tss.ParsedMessage, partyCount)
+	p.temp.repairRound2Messages = make([]tss.ParsedMessage, partyCount)
+	// temp data init
+	p.temp.lostIdx = -1
+	for j, Pj := range params.Parties().IDs() {
+		if lost != nil && Pj.KeyInt().Cmp(lost.KeyInt()) == 0 {
+			p.temp.lostIdx = j
+		}
+	}
+	if p.temp.lostIdx < 0 {
+		panic(errors.New("repair.NewLocalParty: the lost party is not one of the parties"))
+	}
+	subset := keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs())
+	p.temp.bigXs = subset.BigXj
+	p.temp.ks = subset.Ks
+	p.temp.commitments = make([][]*crypto.ECPoint, partyCount)
+	return p
+}
+func (p *LocalParty) FirstRound() tss.Round {
+	return newRound1(p.params, p.save, &p.temp, p.Outbox())
+}
+
+func (p *LocalParty) Start() *tss.Error {
+	return tss.BaseStart(p, TaskName, func(round tss.Round) *tss.Error {
+		if _, ok := round.(*round1); !ok {
+			return round.WrapError(errors.New("unable to Start(). party is in an unexpected round"))
+		}
+		return nil
+	})
+}
+
+func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
+	opened, openErr := p.params.OpenMessage(msg)
+	if openErr != nil {
+		return false, p.WrapError(openErr)
+	}
+	return tss.BaseUpdate(p, opened, TaskName)
+}
+
+func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := tss.ParseSessionWireMessage(wireBytes, from, isBroadcast, p.params.SessionID())
+	if err != nil {
+		return false, p.WrapError(err)
+	}
+	return p.Update(msg)
+}
+
+func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	if ok, err := p.BaseParty.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	// check that the message's "from index" will fit into the array
+	if maxFromIdx := len(p.params.Parties().IDs()) - 1; maxFromIdx < msg.GetFrom().Index {
+		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+	}
+	if err := p.params.ValidateSessionID(msg); err != nil {
+		return false, p.WrapError(err, msg.GetFrom())
+	}
+	return true, nil
+}
+
+func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	// ValidateBasic is cheap; double-check the message here in case the public StoreMessage was called externally
+	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	fromPIdx := msg.GetFrom().Index
+
+	// switch/case is necessary to store any messages beyond current round
+	// replays and equivocations are caught by tss.BaseUpdate before this point. we expect the caller to apply spoofing protection.
+	switch msg.Content().(type) {
+	case *RepairRound1Message1:
+		p.temp.repairRound1Message1s[fromPIdx] = msg
+	case *RepairRound1Message2:
+		p.temp.repairRound1Message2s[fromPIdx] = msg
+	case *RepairRound2Message:
+		p.temp.repairRound2Messages[fromPIdx] = msg
+	default: // unrecognised message, just ignore!
+		p.params.Logger(TaskName, 0).Warn("unrecognised message ignored", common.StringField("msg", msg.String()))
+		return false, nil
+	}
+	return true, nil
+}
+
+func (p *LocalParty) PartyID() *tss.PartyID {
+	return p.params.PartyID()
+}
+
+func (p *LocalParty) String() string {
+	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
+}
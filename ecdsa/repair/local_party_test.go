@@ -0,0 +1,116 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package repair
+
+import (
+	"testing"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/test"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+// repair runs the share repair protocol for keys[lost] with the helpers at the given fixture indexes
+func repair(t *testing.T, keys []keygen.LocalPartySaveData, pIDs tss.SortedPartyIDs, lost int, helpers []int) keygen.LocalPartySaveData {
+	keysByParty := make(map[string]keygen.LocalPartySaveData, len(helpers)+1)
+	unsorted := make(tss.UnSortedPartyIDs, 0, len(helpers)+1)
+	for _, j := range append(helpers, lost) {
+		key := keys[j]
+		if j == lost {
+			key.Xi = nil
+		}
+		keysByParty[pIDs[j].Id] = key
+		unsorted = append(unsorted, tss.NewPartyID(pIDs[j].Id, pIDs[j].Moniker, pIDs[j].KeyInt()))
+	}
+	repairPIDs := tss.SortPartyIDs(unsorted)
+	p2pCtx := tss.NewPeerContext(repairPIDs)
+	errCh := make(chan *tss.Error, len(repairPIDs))
+	outCh := make(chan tss.Message, len(repairPIDs))
+	endCh := make(chan *keygen.LocalPartySaveData, len(repairPIDs))
+
+	parties := make([]*LocalParty, 0, len(repairPIDs))
+	var lostPID *tss.PartyID
+	for _, pID := range repairPIDs {
+		if pID.Id == pIDs[lost].Id {
+			lostPID = pID
+		}
+	}
+	for _, pID := range repairPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(repairPIDs), testThreshold)
+		P := NewLocalParty(params, keysByParty[pID.Id], lostPID, outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	var repaired keygen.LocalPartySaveData
+	for ended := 0; ended < len(repairPIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go test.SharedPartyUpdater(P, msg, errCh)
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case save := <-endCh:
+			if save.ShareID.Cmp(pIDs[lost].KeyInt()) == 0 {
+				repaired = *save
+			} else {
+				index, err := save.OriginalIndex()
+				assert.NoError(t, err)
+				assert.Equal(t, 0, keys[index].Xi.Cmp(save.Xi), "the shares of the helpers must not change")
+			}
+			ended++
+		}
+	}
+	// the parties are done once the updates that ended them return
+	for _, P := range parties {
+		assert.Empty(t, P.WaitingFor(), "a finished party must not wait for anyone")
+	}
+	return repaired
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	lost := 2
+	for _, helpers := range [][]int{{0, 3, 4}, {0, 1, 3, 4}} {
+		repaired := repair(t, keys, pIDs, lost, helpers)
+		assert.NotNil(t, repaired.Xi)
+		assert.Equal(t, 0, keys[lost].Xi.Cmp(repaired.Xi), "the lost share must be recovered")
+		assert.True(t, keys[lost].ECDSAPub.Equals(repaired.ECDSAPub))
+		assert.Equal(t, len(keys[lost].Ks), len(repaired.Ks), "the save data must still cover every party")
+	}
+}
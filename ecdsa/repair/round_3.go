@@ -0,0 +1,88 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package repair
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	r := round.temp.lostIdx
+	ec := round.EC()
+	modN := common.ModInt(ec.Params().N)
+
+	// 1. verify the sum of each helper Pj against the commitments to the parts it received
+	xr := big.NewInt(0)
+	culprits := make([]*tss.PartyID, 0, len(Ps))
+	for j, Pj := range Ps {
+		if round.isLost(j) {
+			continue
+		}
+		var expected *crypto.ECPoint
+		var err error
+		for l := range Ps {
+			if round.isLost(l) {
+				continue
+			}
+			commitment := round.temp.commitments[l][round.helperPos(j)]
+			if expected == nil {
+				expected = commitment
+			} else if expected, err = expected.Add(commitment); err != nil {
+				break
+			}
+		}
+		sigma := round.temp.repairRound2Messages[j].Content().(*RepairRound2Message).UnmarshalShare()
+		if err != nil || !crypto.ScalarBaseMult(ec, sigma).Equals(expected) {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		xr = modN.Add(xr, sigma)
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(errors.New("a sum did not match the commitments to its parts"), culprits...)
+	}
+
+	// 2. the repaired share must match the public share
+	if !crypto.ScalarBaseMult(ec, xr).Equals(round.temp.bigXs[r]) {
+		return round.WrapError(errors.New("the repaired share does not match its public share"))
+	}
+
+	// SAVE the repaired share
+	round.save.Xi = xr
+	for j := range round.ok {
+		round.ok[j] = true
+	}
+	round.out.Finish(round.save)
+	return nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *round3) NextRound() tss.Round {
+	return nil // finished!
+}
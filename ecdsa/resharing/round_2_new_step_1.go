@@ -8,11 +8,13 @@ package resharing
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/v2/crypto/modproof"
 
+	"github.com/bnb-chain/tss-lib/v2/common"
 	"github.com/bnb-chain/tss-lib/v2/crypto/dlnproof"
 	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
 	"github.com/bnb-chain/tss-lib/v2/tss"
@@ -57,7 +59,7 @@ func (round *round2) Start() *tss.Error {
 	r2msg1 := NewDGRound2Message2(
 		round.OldParties().IDs().Exclude(round.PartyID()), round.PartyID())
 	round.temp.dgRound2Message2s[i] = r2msg1
-	round.out <- r2msg1
+	round.out.Send(r2msg1)
 
 	// 1.
 	// generate Paillier public key E_i, private key and proof
@@ -72,7 +74,9 @@ func (round *round2) Start() *tss.Error {
 		preParams = &round.save.LocalPreParams
 	} else {
 		var err error
-		preParams, err = keygen.GeneratePreParams(round.SafePrimeGenTimeout(), round.Concurrency())
+		ctx, cancel := context.WithTimeout(common.ContextWithLogger(round.Context(), round.Logger(TaskName, round.number)), round.SafePrimeGenTimeout())
+		defer cancel()
+		preParams, err = keygen.GeneratePreParamsWithContext(ctx, round.Concurrency())
 		if err != nil {
 			return round.WrapError(errors.New("pre-params generation failed"), Pi)
 		}
@@ -90,14 +94,14 @@ func (round *round2) Start() *tss.Error {
 		preParams.P,
 		preParams.Q,
 		preParams.NTildei
-	dlnProof1 := dlnproof.NewDLNProof(h1i, h2i, alpha, p, q, NTildei)
-	dlnProof2 := dlnproof.NewDLNProof(h2i, h1i, beta, p, q, NTildei)
+	dlnProof1 := dlnproof.NewDLNProofWithRand(round.Rand(), h1i, h2i, alpha, p, q, NTildei)
+	dlnProof2 := dlnproof.NewDLNProofWithRand(round.Rand(), h2i, h1i, beta, p, q, NTildei)
 
 	modProof := &modproof.ProofMod{W: zero, X: *new([80]*big.Int), A: zero, B: zero, Z: *new([80]*big.Int)}
 	ContextI := append(round.temp.ssid, big.NewInt(int64(i)).Bytes()...)
 	if !round.Parameters.NoProofMod() {
 		var err error
-		modProof, err = modproof.NewProof(ContextI, preParams.PaillierSK.N, preParams.PaillierSK.P, preParams.PaillierSK.Q)
+		modProof, err = modproof.NewProofWithRand(round.Rand(), ContextI, preParams.PaillierSK.N, preParams.PaillierSK.P, preParams.PaillierSK.Q)
 		if err != nil {
 			return round.WrapError(err, Pi)
 		}
@@ -109,7 +113,7 @@ func (round *round2) Start() *tss.Error {
 		return round.WrapError(err, Pi)
 	}
 	round.temp.dgRound2Message1s[i] = r2msg2
-	round.out <- r2msg2
+	round.out.Send(r2msg2)
 
 	// for this P: SAVE de-commitments, paillier keys for round 2
 	round.save.PaillierSK = preParams.PaillierSK
@@ -39,12 +39,8 @@ func (round *round4) Start() *tss.Error {
 		return nil
 	}
 
-	common.Logger.Debugf(
-		"%s Setting up DLN verification with concurrency level of %d",
-		round.PartyID(),
-		round.Concurrency(),
-	)
-	dlnVerifier := keygen.NewDlnProofVerifier(round.Concurrency())
+	round.Logger(TaskName, round.number).Debug("setting up DLN verification", common.IntField("concurrency", round.Concurrency()))
+	dlnVerifier := keygen.NewDlnProofVerifierWithContext(round.Context(), round.Concurrency())
 
 	Pi := round.PartyID()
 	i := Pi.Index
@@ -82,13 +78,13 @@ func (round *round4) Start() *tss.Error {
 				if !round.Parameters.NoProofMod() {
 					paiProofCulprits[j] = msg.GetFrom()
 				}
-				common.Logger.Warningf("modProof verify failed for party %s", msg.GetFrom(), err)
+				round.Logger(TaskName, round.number).Warn("modProof verify failed", common.StringField("peer", msg.GetFrom().String()), common.ErrorField(err))
 				return
 			}
 			ContextJ := common.AppendBigIntToBytesSlice(round.temp.ssid, big.NewInt(int64(j)))
 			if ok := modProof.Verify(ContextJ, paiPK.N); !ok {
 				paiProofCulprits[j] = msg.GetFrom()
-				common.Logger.Warningf("modProof verify failed for party %s", msg.GetFrom(), err)
+				round.Logger(TaskName, round.number).Warn("modProof verify failed", common.StringField("peer", msg.GetFrom().String()))
 			}
 		}(j, msg, r2msg1)
 		_j := j
@@ -96,19 +92,22 @@ func (round *round4) Start() *tss.Error {
 		dlnVerifier.VerifyDLNProof1(r2msg1, H1j, H2j, NTildej, func(isValid bool) {
 			if !isValid {
 				dlnProof1FailCulprits[_j] = _msg.GetFrom()
-				common.Logger.Warningf("dln proof 1 verify failed for party %s", _msg.GetFrom())
+				round.Logger(TaskName, round.number).Warn("dln proof 1 verify failed", common.StringField("peer", _msg.GetFrom().String()))
 			}
 			wg.Done()
 		})
 		dlnVerifier.VerifyDLNProof2(r2msg1, H2j, H1j, NTildej, func(isValid bool) {
 			if !isValid {
 				dlnProof2FailCulprits[_j] = _msg.GetFrom()
-				common.Logger.Warningf("dln proof 2 verify failed for party %s", _msg.GetFrom())
+				round.Logger(TaskName, round.number).Warn("dln proof 2 verify failed", common.StringField("peer", _msg.GetFrom().String()))
 			}
 			wg.Done()
 		})
 	}
 	wg.Wait()
+	if err := round.Context().Err(); err != nil {
+		return round.WrapError(err)
+	}
 	for _, culprit := range append(append(paiProofCulprits, dlnProof1FailCulprits...), dlnProof2FailCulprits...) {
 		if culprit != nil {
 			return round.WrapError(errors.New("dln proof verification failed"), culprit)
@@ -221,20 +220,21 @@ func (round *round4) Start() *tss.Error {
 		facProof := &facproof.ProofFac{P: zero, Q: zero, A: zero, B: zero, T: zero, Sigma: zero,
 			Z1: zero, Z2: zero, W1: zero, W2: zero, V: zero}
 		if !round.Parameters.NoProofFac() {
-			facProof, err = facproof.NewProof(ContextJ, round.EC(), round.save.PaillierSK.N, round.save.NTildej[j],
+			facProof, err = facproof.NewProofWithRand(
+				round.Rand(), ContextJ, round.EC(), round.save.PaillierSK.N, round.save.NTildej[j],
 				round.save.H1j[j], round.save.H2j[j], round.save.PaillierSK.P, round.save.PaillierSK.Q)
 			if err != nil {
 				return round.WrapError(err, Pi)
 			}
 		}
 		r4msg1 := NewDGRound4Message1(Pj, Pi, facProof)
-		round.out <- r4msg1
+		round.out.Send(r4msg1)
 	}
 
 	// Send an "ACK" message to both committees to signal that we're ready to save our data
 	r4msg2 := NewDGRound4Message2(round.OldAndNewParties(), Pi)
 	round.temp.dgRound4Message2s[i] = r4msg2
-	round.out <- r4msg2
+	round.out.Send(r4msg2)
 
 	return nil
 }
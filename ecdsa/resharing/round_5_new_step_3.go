@@ -51,15 +51,15 @@ func (round *round5) Start() *tss.Error {
 			r4msg1 := msg.Content().(*DGRound4Message1)
 			proof, err := r4msg1.UnmarshalFacProof()
 			if err != nil && round.Parameters.NoProofFac() {
-				common.Logger.Warningf("facProof verify failed for party %s", msg.GetFrom(), err)
+				round.Logger(TaskName, round.number).Warn("facProof verify failed", common.StringField("peer", msg.GetFrom().String()), common.ErrorField(err))
 			} else {
 				if err != nil {
-					common.Logger.Warningf("facProof verify failed for party %s", msg.GetFrom(), err)
+					round.Logger(TaskName, round.number).Warn("facProof verify failed", common.StringField("peer", msg.GetFrom().String()), common.ErrorField(err))
 					return round.WrapError(err, round.NewParties().IDs()[j])
 				}
 				if ok := proof.Verify(ContextI, round.EC(), round.save.PaillierPKs[j].N, round.save.NTildei,
 					round.save.H1i, round.save.H2i); !ok {
-					common.Logger.Warningf("facProof verify failed for party %s", msg.GetFrom(), err)
+					round.Logger(TaskName, round.number).Warn("facProof verify failed", common.StringField("peer", msg.GetFrom().String()), common.ErrorField(err))
 					return round.WrapError(err, round.NewParties().IDs()[j])
 				}
 			}
@@ -69,7 +69,7 @@ func (round *round5) Start() *tss.Error {
 		round.input.Xi.SetInt64(0)
 	}
 
-	round.end <- round.save
+	round.out.Finish(round.save)
 	return nil
 }
 
@@ -25,8 +25,7 @@ type (
 		*tss.ReSharingParameters
 		temp        *localTempData
 		input, save *keygen.LocalPartySaveData
-		out         chan<- tss.Message
-		end         chan<- *keygen.LocalPartySaveData
+		out         *tss.Outbox
 		oldOK,      // old committee "ok" tracker
 		newOK []bool // `ok` tracks parties which have been verified by Update(); this one is for the new committee
 		started bool
@@ -0,0 +1,195 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// AuditTranscript verifies the public proofs in the transcript of a signing party: the MtA range proofs of round 1,
+// the MtA proofs of round 2 and the Schnorr proofs of rounds 4 and 6. Only the public fields of key are read, so the
+// save data of any party of the keygen will do; with key derivation it must hold the adjusted BigXj. The MtA proofs
+// are sent point-to-point and only those between the party that recorded the transcript and its peers can be checked.
+// The returned error names every party that sent an invalid proof, or is nil when the proofs in the transcript are all
+// valid.
+func AuditTranscript(t *tss.Transcript, key keygen.LocalPartySaveData) *tss.Error {
+	params, err := t.Parameters()
+	if err != nil {
+		return tss.NewError(err, TaskName, -1, nil)
+	}
+	msgs, err := t.Messages()
+	if err != nil {
+		return tss.NewError(err, TaskName, -1, nil)
+	}
+	Ps := params.Parties().IDs()
+	known := make(map[string]bool, len(key.Ks))
+	for _, kj := range key.Ks {
+		if kj != nil {
+			known[kj.String()] = true
+		}
+	}
+	for _, Pj := range Ps {
+		if !known[Pj.KeyInt().String()] {
+			return tss.NewError(fmt.Errorf("party %s is not in the key", Pj), TaskName, -1, nil)
+		}
+	}
+	key = keygen.BuildLocalSaveDataSubset(key, Ps)
+
+	// point-to-point messages are indexed by sender and recipient
+	r1msg1s, r2msgs := make([][]*SignRound1Message1, len(Ps)), make([][]*SignRound2Message, len(Ps))
+	for j := range Ps {
+		r1msg1s[j], r2msgs[j] = make([]*SignRound1Message1, len(Ps)), make([]*SignRound2Message, len(Ps))
+	}
+	r1msg2s, r3msgs := make([]*SignRound1Message2, len(Ps)), make([]*SignRound3Message, len(Ps))
+	r4msgs, r5msgs, r6msgs := make([]*SignRound4Message, len(Ps)), make([]*SignRound5Message, len(Ps)), make([]*SignRound6Message, len(Ps))
+	for _, m := range msgs {
+		j, k := m.Msg.GetFrom().Index, params.PartyID().Index
+		if m.Sent && len(m.To) == 1 {
+			k = m.To[0].Index
+		}
+		switch content := m.Msg.Content().(type) {
+		case *SignRound1Message1:
+			r1msg1s[j][k] = content
+		case *SignRound1Message2:
+			r1msg2s[j] = content
+		case *SignRound2Message:
+			r2msgs[j][k] = content
+		case *SignRound3Message:
+			r3msgs[j] = content
+		case *SignRound4Message:
+			r4msgs[j] = content
+		case *SignRound5Message:
+			r5msgs[j] = content
+		case *SignRound6Message:
+			r6msgs[j] = content
+		}
+	}
+
+	round := &base{Parameters: params, key: &key, temp: &localTempData{}, number: 1}
+	round.temp.ssidNonce = params.SSIDNonce()
+	ssid, err := round.getSSID()
+	if err != nil {
+		return round.WrapError(err)
+	}
+	if params.Threshold()+1 > len(key.Ks) {
+		return round.WrapError(fmt.Errorf("t+1=%d is not satisfied by the key count of %d", params.Threshold()+1, len(key.Ks)))
+	}
+	_, bigWs := PrepareForSigning(params.EC(), params.PartyID().Index, len(key.Ks), big.NewInt(0), key.Ks, key.BigXj)
+	audit := tss.NewTranscriptAudit(TaskName, params.PartyID())
+	ec := params.EC()
+
+	// rounds 1 and 2: MtA proofs between Alice k and Bob j
+	for j := range Ps {
+		ContextJ := append(ssid, new(big.Int).SetUint64(uint64(j)).Bytes()...)
+		for k := range Ps {
+			if r1msg1 := r1msg1s[j][k]; r1msg1 != nil {
+				proof, err := r1msg1.UnmarshalRangeProofAlice()
+				if err != nil || !proof.Verify(ec, key.PaillierPKs[j], key.NTildej[k], key.H1j[k], key.H2j[k], r1msg1.UnmarshalC()) {
+					audit.Fail(1, Ps[j], fmt.Errorf("range proof to party %s verify failed", Ps[k]))
+				}
+			}
+			r2msg, r1msg1 := r2msgs[j][k], r1msg1s[k][j]
+			if r2msg == nil || r1msg1 == nil {
+				continue
+			}
+			cA := r1msg1.UnmarshalC()
+			proofBob, err := r2msg.UnmarshalProofBob()
+			if err != nil || !proofBob.Verify(ContextJ, ec, key.PaillierPKs[k], key.NTildej[k], key.H1j[k], key.H2j[k],
+				cA, new(big.Int).SetBytes(r2msg.GetC1())) {
+				audit.Fail(2, Ps[j], fmt.Errorf("mta proof to party %s verify failed", Ps[k]))
+			}
+			proofBobWC, err := r2msg.UnmarshalProofBobWC(ec)
+			if err != nil || !proofBobWC.Verify(ContextJ, ec, key.PaillierPKs[k], key.NTildej[k], key.H1j[k], key.H2j[k],
+				cA, new(big.Int).SetBytes(r2msg.GetC2()), bigWs[j]) {
+				audit.Fail(2, Ps[j], fmt.Errorf("mta wc proof to party %s verify failed", Ps[k]))
+			}
+		}
+	}
+
+	// round 4: Schnorr proofs of bigGamma; R is only known when every party got that far
+	modN := common.ModInt(ec.Params().N)
+	theta, R := big.NewInt(0), (*crypto.ECPoint)(nil)
+	for j := range Ps {
+		if r3msgs[j] == nil || r4msgs[j] == nil || r1msg2s[j] == nil {
+			theta = nil
+		}
+		if r4msgs[j] == nil || r1msg2s[j] == nil {
+			continue
+		}
+		cmtDeCmt := commitments.HashCommitDecommit{C: r1msg2s[j].UnmarshalCommitment(), D: r4msgs[j].UnmarshalDeCommitment()}
+		ok, bigGammaJ := cmtDeCmt.DeCommit()
+		if !ok || len(bigGammaJ) != 2 {
+			audit.Fail(4, Ps[j], errors.New("commitment verify failed"))
+			theta = nil
+			continue
+		}
+		bigGammaJPoint, err := crypto.NewECPoint(ec, bigGammaJ[0], bigGammaJ[1])
+		if err != nil {
+			audit.Fail(4, Ps[j], err)
+			theta = nil
+			continue
+		}
+		ContextJ := common.AppendBigIntToBytesSlice(ssid, big.NewInt(int64(j)))
+		proof, err := r4msgs[j].UnmarshalZKProof(ec)
+		if err != nil || !proof.Verify(ContextJ, bigGammaJPoint) {
+			audit.Fail(4, Ps[j], errors.New("failed to prove bigGamma"))
+		}
+		if theta == nil {
+			continue
+		}
+		theta = modN.Add(theta, new(big.Int).SetBytes(r3msgs[j].GetTheta()))
+		if R == nil {
+			R = bigGammaJPoint
+		} else if R, err = R.Add(bigGammaJPoint); err != nil {
+			audit.Fail(4, Ps[j], err)
+			theta = nil
+		}
+	}
+	if theta != nil && R != nil && theta.Sign() != 0 {
+		R = R.ScalarMult(modN.ModInverse(theta))
+	} else {
+		R = nil
+	}
+
+	// round 6: Schnorr proofs of bigAj and bigVj
+	for j := range Ps {
+		if r5msgs[j] == nil || r6msgs[j] == nil {
+			continue
+		}
+		cmtDeCmt := commitments.HashCommitDecommit{C: r5msgs[j].UnmarshalCommitment(), D: r6msgs[j].UnmarshalDeCommitment()}
+		ok, values := cmtDeCmt.DeCommit()
+		if !ok || len(values) != 4 {
+			audit.Fail(6, Ps[j], errors.New("de-commitment for bigVj and bigAj failed"))
+			continue
+		}
+		bigVj, errV := crypto.NewECPoint(ec, values[0], values[1])
+		bigAj, errA := crypto.NewECPoint(ec, values[2], values[3])
+		if errV != nil || errA != nil {
+			audit.Fail(6, Ps[j], errors.New("de-committed bigVj or bigAj is not on the curve"))
+			continue
+		}
+		ContextJ := common.AppendBigIntToBytesSlice(ssid, big.NewInt(int64(j)))
+		if pijA, err := r6msgs[j].UnmarshalZKProof(ec); err != nil || !pijA.Verify(ContextJ, bigAj) {
+			audit.Fail(6, Ps[j], errors.New("schnorr verify for Aj failed"))
+		}
+		if R == nil {
+			continue
+		}
+		if pijV, err := r6msgs[j].UnmarshalZKVProof(ec); err != nil || !pijV.Verify(ContextJ, bigVj, R) {
+			audit.Fail(6, Ps[j], errors.New("vverify for Vj failed"))
+		}
+	}
+	return audit.Err()
+}
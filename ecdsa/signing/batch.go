@@ -0,0 +1,293 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// Batch signing
+//
+// A BatchLocalParty signs several messages with one key in a single protocol run. It runs an instance of the
+// signing protocol for every message in lockstep: each step starts the current round of all the instances, and
+// the messages they send to the same parties travel together in one SignBatchMessage. Every instance has an SSID
+// of its own, so a proof made for one instance is not accepted by another. An error raised by an instance has a
+// *BatchInstanceError as its cause, which names the instance.
+
+const (
+	BatchTaskName = "batch-signing"
+)
+
+// Implements Party
+// Implements Stringer
+var _ tss.Party = (*BatchLocalParty)(nil)
+var _ fmt.Stringer = (*BatchLocalParty)(nil)
+
+type (
+	BatchLocalParty struct {
+		*tss.BaseParty
+		params *tss.Parameters
+
+		instances []*batchInstance
+		data      []*common.SignatureData
+	}
+
+	batchInstance struct {
+		party *LocalParty
+		// the messages given to the party so far, keyed by sender and message type
+		accepted map[string]tss.ParsedMessage
+	}
+
+	// BatchInstanceError is the cause of an error raised by one instance of a batch
+	BatchInstanceError struct {
+		Instance int
+		Err      error
+	}
+)
+
+// NewBatchLocalParty returns a party which signs each of `msgs` with `key`. Once every signature is ready they are
+// sent to `end` together, in the order of `msgs`. The instances take a copy of `params`, which must therefore be
+// set up before the party is created.
+func NewBatchLocalParty(
+	msgs []*big.Int,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- []*common.SignatureData,
+) (tss.Party, error) {
+	return NewBatchLocalPartyWithKDD(msgs, params, key, nil, out, end)
+}
+
+// NewBatchLocalPartyWithKDD is NewBatchLocalParty with a key derivation delta for each message, for HD support.
+// Unlike NewLocalPartyWithKDD it takes the parent key: the key of the instance i is derived from `key` by adding
+// keyDerivationDeltas[i]·G to its public key and BigXj. A nil delta signs with `key` itself.
+func NewBatchLocalPartyWithKDD(
+	msgs []*big.Int,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	keyDerivationDeltas []*big.Int,
+	out chan<- tss.Message,
+	end chan<- []*common.SignatureData,
+) (tss.Party, error) {
+	if len(msgs) == 0 {
+		return nil, errors.New("NewBatchLocalParty: no messages to sign")
+	}
+	if keyDerivationDeltas != nil && len(keyDerivationDeltas) != len(msgs) {
+		return nil, fmt.Errorf("NewBatchLocalParty: %d key derivation deltas given for %d messages", len(keyDerivationDeltas), len(msgs))
+	}
+	p := &BatchLocalParty{
+		BaseParty: tss.NewBaseParty(params, out, func(result interface{}) { end <- result.([]*common.SignatureData) }),
+		params:    params,
+		instances: make([]*batchInstance, len(msgs)),
+		data:      make([]*common.SignatureData, len(msgs)),
+	}
+	for i, msg := range msgs {
+		if msg == nil {
+			return nil, fmt.Errorf("NewBatchLocalParty: message %d is nil", i)
+		}
+		instKey, delta := key, (*big.Int)(nil)
+		if keyDerivationDeltas != nil && keyDerivationDeltas[i] != nil {
+			delta = keyDerivationDeltas[i]
+			var err error
+			if instKey, err = deriveSaveData(key, delta, params.EC()); err != nil {
+				return nil, fmt.Errorf("NewBatchLocalParty: deriving the key of message %d: %v", i, err)
+			}
+		}
+		// the batch takes the messages and signature of the instance from its outbox
+		inst := &batchInstance{
+			accepted: make(map[string]tss.ParsedMessage),
+		}
+		inst.party = NewLocalPartyWithKDD(msg, params.BatchInstance(i), instKey, delta, nil, nil).(*LocalParty)
+		p.instances[i] = inst
+	}
+	return p, nil
+}
+
+// deriveSaveData returns a copy of `key` for the child key at `delta`, as UpdatePublicKeyAndAdjustBigXj does
+func deriveSaveData(key keygen.LocalPartySaveData, delta *big.Int, ec elliptic.Curve) (keygen.LocalPartySaveData, error) {
+	gDelta := crypto.ScalarBaseMult(ec, delta)
+	pub, err := key.ECDSAPub.Add(gDelta)
+	if err != nil {
+		return key, err
+	}
+	key.ECDSAPub = pub
+	bigXj := make([]*crypto.ECPoint, len(key.BigXj))
+	for j, Xj := range key.BigXj {
+		if bigXj[j], err = Xj.Add(gDelta); err != nil {
+			return key, err
+		}
+	}
+	key.BigXj = bigXj
+	return key, nil
+}
+
+func (p *BatchLocalParty) FirstRound() tss.Round {
+	rounds := make([]tss.Round, len(p.instances))
+	for i, inst := range p.instances {
+		rounds[i] = inst.party.FirstRound()
+	}
+	return &batchRound{Parameters: p.params, party: p, rounds: rounds, number: 1}
+}
+
+func (p *BatchLocalParty) Start() *tss.Error {
+	return tss.BaseStart(p, BatchTaskName, func(round tss.Round) *tss.Error {
+		batch, ok := round.(*batchRound)
+		if !ok {
+			return round.WrapError(errors.New("unable to Start(). party is in an unexpected round"))
+		}
+		for i, r := range batch.rounds {
+			round1, ok := r.(*round1)
+			if !ok {
+				return round.WrapError(&BatchInstanceError{Instance: i, Err: errors.New("unable to Start(). instance is in an unexpected round")})
+			}
+			if err := round1.prepare(); err != nil {
+				return round.WrapError(&BatchInstanceError{Instance: i, Err: err})
+			}
+		}
+		return nil
+	})
+}
+
+func (p *BatchLocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
+	opened, openErr := p.params.OpenMessage(msg)
+	if openErr != nil {
+		return false, p.WrapError(openErr)
+	}
+	return tss.BaseUpdate(p, opened, BatchTaskName)
+}
+
+func (p *BatchLocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := tss.ParseSessionWireMessage(wireBytes, from, isBroadcast, p.params.SessionID())
+	if err != nil {
+		return false, p.WrapError(err)
+	}
+	return p.Update(msg)
+}
+
+func (p *BatchLocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	if ok, err := p.BaseParty.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	// check that the message's "from index" will fit into the array
+	if maxFromIdx := len(p.params.Parties().IDs()) - 1; maxFromIdx < msg.GetFrom().Index {
+		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+	}
+	if err := p.params.ValidateSessionID(msg); err != nil {
+		return false, p.WrapError(err, msg.GetFrom())
+	}
+	return true, nil
+}
+
+// StoreMessage hands every message in a bundle to the instance it belongs to
+func (p *BatchLocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	// ValidateBasic is cheap; double-check the message here in case the public StoreMessage was called externally
+	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	content, ok := msg.Content().(*SignBatchMessage)
+	if !ok { // unrecognised message, just ignore!
+		p.params.Logger(BatchTaskName, 0).Warn("unrecognised message ignored", common.StringField("msg", msg.String()))
+		return false, nil
+	}
+	if content.GetBroadcast() != msg.IsBroadcast() {
+		return false, p.WrapError(errors.New("received a batch message with a broadcast flag that does not match its routing"), msg.GetFrom())
+	}
+	for _, entry := range content.GetEntries() {
+		i := int(entry.GetInstance())
+		if len(p.instances) <= i {
+			return false, p.WrapError(fmt.Errorf("received a batch message for instance %d of %d", i, len(p.instances)), msg.GetFrom())
+		}
+		if ok, err := p.instances[i].store(msg, entry); err != nil {
+			return false, wrapBatchError(err, i)
+		} else if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (p *BatchLocalParty) PartyID() *tss.PartyID {
+	return p.params.PartyID()
+}
+
+func (p *BatchLocalParty) String() string {
+	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
+}
+
+// ----- //
+
+// store gives an entry of a bundle to the party of the instance. A message replayed in a later bundle is ignored,
+// while a different message in its place is an equivocation.
+func (inst *batchInstance) store(bundle tss.ParsedMessage, entry *SignBatchMessage_Entry) (bool, *tss.Error) {
+	m, err := entry.GetMessage().UnmarshalNew()
+	if err != nil {
+		return false, inst.party.WrapError(err, bundle.GetFrom())
+	}
+	content, ok := m.(tss.MessageContent)
+	if !ok {
+		return false, inst.party.WrapError(errors.New("received a batch entry with unknown content"), bundle.GetFrom())
+	}
+	if _, nested := content.(*SignBatchMessage); nested {
+		return false, inst.party.WrapError(errors.New("received a batch entry holding a batch message"), bundle.GetFrom())
+	}
+	meta := tss.MessageRouting{
+		From:        bundle.GetFrom(),
+		To:          bundle.GetTo(),
+		IsBroadcast: bundle.IsBroadcast(),
+	}
+	wire := tss.NewMessageWrapper(meta, content)
+	wire.SessionId = inst.party.params.SessionID()
+	msg := tss.NewMessage(meta, content, wire)
+
+	key := fmt.Sprintf("%x/%s", msg.GetFrom().GetKey(), msg.Type())
+	if prev, ok := inst.accepted[key]; ok {
+		if proto.Equal(prev.Content(), content) {
+			return true, nil
+		}
+		return false, inst.party.WrapError(fmt.Errorf("equivocation: received a conflicting %s from party %s", msg.Type(), msg.GetFrom()), msg.GetFrom())
+	}
+	if ok, err := inst.party.StoreMessage(msg); err != nil || !ok {
+		return ok, err
+	}
+	inst.accepted[key] = msg
+	return true, nil
+}
+
+// ----- //
+
+func (e *BatchInstanceError) Error() string {
+	return fmt.Sprintf("batch instance %d: %v", e.Instance, e.Err)
+}
+
+func (e *BatchInstanceError) Unwrap() error {
+	return e.Err
+}
+
+// BatchInstanceOf returns the instance of a batch that raised `err`
+func BatchInstanceOf(err error) (int, bool) {
+	var instErr *BatchInstanceError
+	if errors.As(err, &instErr) {
+		return instErr.Instance, true
+	}
+	return -1, false
+}
+
+// wrapBatchError turns an error of an instance into an error of the batch, keeping its round and culprits
+func wrapBatchError(err *tss.Error, instance int) *tss.Error {
+	return tss.NewError(&BatchInstanceError{Instance: instance, Err: err.Cause()}, BatchTaskName, err.Round(), err.Victim(), err.Culprits()...)
+}
@@ -0,0 +1,177 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// batchRound is a step of a batch: it holds the current round of every instance
+type batchRound struct {
+	*tss.Parameters
+	party *BatchLocalParty
+	// nil once the instance has finished
+	rounds  []tss.Round
+	started bool
+	number  int
+}
+
+var _ tss.Round = (*batchRound)(nil)
+
+func (round *batchRound) Params() *tss.Parameters {
+	return round.Parameters
+}
+
+func (round *batchRound) RoundNumber() int {
+	return round.number
+}
+
+func (round *batchRound) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.started = true
+
+	for i, r := range round.rounds {
+		if r == nil {
+			continue
+		}
+		if err := r.Start(); err != nil {
+			return wrapBatchError(err, i)
+		}
+	}
+	// the rounds of signing only send messages and output their data when they start
+	round.bundle()
+	return nil
+}
+
+func (round *batchRound) Update() (bool, *tss.Error) {
+	ret := true
+	for i, r := range round.rounds {
+		if r == nil {
+			continue
+		}
+		ok, err := r.Update()
+		if err != nil {
+			return false, wrapBatchError(err, i)
+		}
+		ret = ret && ok
+	}
+	return ret, nil
+}
+
+func (round *batchRound) CanAccept(msg tss.ParsedMessage) bool {
+	_, ok := msg.Content().(*SignBatchMessage)
+	return ok
+}
+
+func (round *batchRound) CanProceed() bool {
+	if !round.started {
+		return false
+	}
+	for _, r := range round.rounds {
+		if r != nil && !r.CanProceed() {
+			return false
+		}
+	}
+	return true
+}
+
+func (round *batchRound) NextRound() tss.Round {
+	round.started = false
+	next := &batchRound{
+		Parameters: round.Parameters,
+		party:      round.party,
+		rounds:     make([]tss.Round, len(round.rounds)),
+		number:     round.number + 1,
+	}
+	finished := true
+	for i, r := range round.rounds {
+		if r == nil {
+			continue
+		}
+		if next.rounds[i] = r.NextRound(); next.rounds[i] != nil {
+			finished = false
+		}
+	}
+	if finished {
+		return nil // finished!
+	}
+	return next
+}
+
+// WaitingFor lists the parties that any of the instances is waiting for
+func (round *batchRound) WaitingFor() []*tss.PartyID {
+	waiting := make(map[int]bool)
+	for _, r := range round.rounds {
+		if r == nil {
+			continue
+		}
+		for _, Pj := range r.WaitingFor() {
+			waiting[Pj.Index] = true
+		}
+	}
+	ids := make([]*tss.PartyID, 0, len(waiting))
+	for j, Pj := range round.Parties().IDs() {
+		if waiting[j] {
+			ids = append(ids, Pj)
+		}
+	}
+	return ids
+}
+
+func (round *batchRound) WrapError(err error, culprits ...*tss.PartyID) *tss.Error {
+	return tss.NewError(err, BatchTaskName, round.number, round.PartyID(), culprits...)
+}
+
+// ----- //
+
+// bundle sends the messages of all the instances in one message to each peer and one broadcast. It takes the
+// signatures of the instances that have finished, and outputs them once all have.
+func (round *batchRound) bundle() {
+	var (
+		keys    []string
+		to      = make(map[string][]*tss.PartyID)
+		entries = make(map[string][]*SignBatchMessage_Entry)
+		p       = round.party
+	)
+	for i, inst := range p.instances {
+		msgs, result, finished := inst.party.Outbox().Take()
+		if finished {
+			p.data[i] = result.(*common.SignatureData)
+		}
+		for _, msg := range msgs {
+			key := "broadcast"
+			if !msg.IsBroadcast() {
+				key = fmt.Sprintf("%v", msg.GetTo())
+			}
+			if _, ok := entries[key]; !ok {
+				keys = append(keys, key)
+				if !msg.IsBroadcast() {
+					to[key] = msg.GetTo()
+				}
+			}
+			entries[key] = append(entries[key], &SignBatchMessage_Entry{
+				Instance: uint32(i),
+				Message:  msg.WireMsg().GetMessage(),
+			})
+		}
+	}
+	for _, key := range keys {
+		round.party.Outbox().Send(NewSignBatchMessage(round.PartyID(), to[key], round.number, entries[key]))
+	}
+	for _, data := range p.data {
+		if data == nil {
+			return
+		}
+	}
+	round.party.Outbox().Finish(p.data)
+}
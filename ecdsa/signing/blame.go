@@ -0,0 +1,383 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"errors"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// The blame phase is entered when Params().IdentifiableAbort() is set and either the U == T check of round 9
+// or the final signature verification fails. Every signer broadcasts its ephemeral values for this session and
+// the blame round checks them against the values committed to in the earlier rounds.
+//
+// After a failure in round 9 the s_i have not been broadcast, so k_i, gamma_i and the MtA shares may be revealed
+// without leaking the secret key. The MtA of k and gamma is opened along with the Paillier randomness used in it.
+// Its ciphertexts were sent P2P, but their hashes were broadcast in rounds 1 and 3, so every party can check the
+// shares against them and name the same parties. The MtAwc shares mu and nu are revealed as points only: opening
+// their ciphertexts would reveal w, so a mismatch is only blamed by the two parties to the exchange. After a failure
+// in finalization only l_i and rho_i are revealed.
+//
+// A party that does not send its blame message cannot be checked. The blame round waits for it like any other round,
+// so a round timeout (see Parameters.SetContext) is needed to end the session with the silent parties as culprits.
+
+const (
+	blameRoundNumber = 11
+)
+
+// startBlame records the failure and broadcasts this party's blame message
+func (round *base) startBlame(cause error, revealMtA bool) {
+	round.temp.blameCause = cause
+	round.resetOK()
+
+	i := round.PartyID().Index
+	round.ok[i] = true
+
+	var r1msg tss.ParsedMessage
+	if revealMtA {
+		n := len(round.ok) - 1
+		alphas, betas := make([]*big.Int, 0, n), make([]*big.Int, 0, n)
+		mus, nus := make([]*crypto.ECPoint, 0, n), make([]*crypto.ECPoint, 0, n)
+		kRands, betaPrms, betaRands := make([]*big.Int, 0, n), make([]*big.Int, 0, n), make([]*big.Int, 0, n)
+		for j := range round.Parties().IDs() {
+			if j == i {
+				continue
+			}
+			alphas = append(alphas, round.temp.alphas[j])
+			betas = append(betas, round.temp.betas[j])
+			mus = append(mus, crypto.ScalarBaseMult(round.EC(), round.temp.us[j]))
+			nus = append(nus, crypto.ScalarBaseMult(round.EC(), round.temp.vs[j]))
+			kRands = append(kRands, round.temp.cisRandomness[j])
+			betaPrms = append(betaPrms, round.temp.betaPrms[j])
+			betaRands = append(betaRands, round.temp.c1jisRandomness[j])
+		}
+		r1msg = NewSignBlameMessage(round.PartyID(), round.temp.li, round.temp.roi, round.temp.k, round.temp.gamma,
+			alphas, betas, mus, nus, kRands, betaPrms, betaRands)
+	} else {
+		r1msg = NewSignBlameMessage(round.PartyID(), round.temp.li, round.temp.roi, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	}
+	round.temp.signBlameMessages[i] = r1msg
+	round.out.Send(r1msg)
+}
+
+func (round *base) updateBlame() (bool, *tss.Error) {
+	for j, msg := range round.temp.signBlameMessages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.canAcceptBlame(msg) {
+			continue
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *base) canAcceptBlame(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*SignBlameMessage); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+// ----- //
+
+func (round *blame) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = blameRoundNumber
+	round.started = true
+	round.resetOK()
+
+	// the s_j were broadcast in round 9 if all parties made it to the finalization
+	if round.temp.signRound9Messages[round.PartyID().Index] != nil {
+		return round.blameFinalization()
+	}
+	return round.blameRound9()
+}
+
+func (round *blame) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *blame) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *blame) NextRound() tss.Round {
+	return nil // finished!
+}
+
+// ----- //
+
+// blameReveal holds the values revealed by a party in its blame message, with the vectors indexed by party
+type blameReveal struct {
+	k, gamma, l                 *big.Int
+	alphas, betas               []*big.Int
+	kRands, betaPrms, betaRands []*big.Int
+	mus, nus                    []*crypto.ECPoint
+}
+
+// blameFinalization checks that each s_j is the value committed to in V_j = s_j * R + l_j * G
+func (round *blame) blameFinalization() *tss.Error {
+	ec := round.Params().EC()
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
+	for j, Pj := range round.Parties().IDs() {
+		r9msg := round.temp.signRound9Messages[j].Content().(*SignRound9Message)
+		bmsg := round.temp.signBlameMessages[j].Content().(*SignBlameMessage)
+		sR := ecMul(ec, round.temp.bigR, r9msg.UnmarshalS())
+		bigVj := ecAdd(ec, sR, ecBaseMul(ec, bmsg.UnmarshalL()))
+		if !bigVj.Equals(round.temp.bigVjs[j]) {
+			culprits = append(culprits, Pj)
+		}
+	}
+	return round.WrapError(round.temp.blameCause, culprits...)
+}
+
+// blameRound9 checks the revealed k_j, gamma_j, l_j, rho_j and MtA shares of every party
+func (round *blame) blameRound9() *tss.Error {
+	ec := round.Params().EC()
+	q := ec.Params().N
+	modN := common.ModInt(q)
+	Ps := round.Parties().IDs()
+	n := len(Ps)
+	i := round.PartyID().Index
+
+	reveals := make([]*blameReveal, n)
+	culprits := make([]*tss.PartyID, 0, n)
+	for j, Pj := range Ps {
+		bmsg := round.temp.signBlameMessages[j].Content().(*SignBlameMessage)
+		if !bmsg.RevealsMtA() || len(bmsg.GetAlphas()) != n-1 {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		muJ, err := bmsg.UnmarshalMus(ec)
+		if err != nil {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		nuJ, err := bmsg.UnmarshalNus(ec)
+		if err != nil {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		rj := &blameReveal{
+			k:         bmsg.UnmarshalK(),
+			gamma:     bmsg.UnmarshalGamma(),
+			l:         bmsg.UnmarshalL(),
+			alphas:    expandInts(bmsg.UnmarshalAlphas(), j),
+			betas:     expandInts(bmsg.UnmarshalBetas(), j),
+			kRands:    expandInts(bmsg.UnmarshalKRandomness(), j),
+			betaPrms:  expandInts(bmsg.UnmarshalBetaPrimes(), j),
+			betaRands: expandInts(bmsg.UnmarshalBetaRandomness(), j),
+			mus:       expandPoints(muJ, j),
+			nus:       expandPoints(nuJ, j),
+		}
+		rhoJ := bmsg.UnmarshalRho()
+
+		// k_j and gamma_j are sampled below q
+		if rj.k.Cmp(q) >= 0 || rj.gamma.Cmp(q) >= 0 {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		reveals[j] = rj
+		// A_j = rho_j * G, U_j = rho_j * V, T_j = l_j * A
+		if !ecBaseMul(ec, rhoJ).Equals(round.temp.bigAjs[j]) ||
+			!ecMul(ec, round.temp.bigV, rhoJ).Equals(round.temp.bigUjs[j]) ||
+			!ecMul(ec, round.temp.bigA, rj.l).Equals(round.temp.bigTjs[j]) {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		// Gamma_j = gamma_j * G
+		if !ecBaseMul(ec, rj.gamma).Equals(round.temp.bigGammaJs[j]) {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		// delta_j = k_j * gamma_j + sum(alpha_ji + beta_ji)
+		deltaJ := modN.Mul(rj.k, rj.gamma)
+		for l := range Ps {
+			if l == j {
+				continue
+			}
+			deltaJ = modN.Add(deltaJ, modN.Add(rj.alphas[l], rj.betas[l]))
+		}
+		r3msg := round.temp.signRound3Messages[j].Content().(*SignRound3Message)
+		if deltaJ.Cmp(new(big.Int).Mod(new(big.Int).SetBytes(r3msg.GetTheta()), q)) != 0 {
+			culprits = append(culprits, Pj)
+		}
+	}
+
+	// the MtA shares of every pair of well-formed reveals are checked too, so that a party that lies in one of them is
+	// named along with the parties caught above
+	for a := range Ps {
+		for b := range Ps {
+			if a == b || reveals[a] == nil || reveals[b] == nil {
+				continue
+			}
+			// the MtA of k_a and gamma_b is checked against its ciphertexts
+			culprits = appendParties(culprits, round.mtaCulprits(a, b, reveals[a], reveals[b])...)
+
+			// mu_ab + nu_ba = k_a * w_b. these shares are only revealed as points and their ciphertexts cannot be
+			// opened, so a party to the exchange names the other side and a third party, which cannot tell who lied,
+			// names neither.
+			if ecAdd(ec, reveals[a].mus[b], reveals[b].nus[a]).Equals(ecMul(ec, round.temp.bigWs[b], reveals[a].k)) {
+				continue
+			}
+			switch i {
+			case a:
+				culprits = appendParties(culprits, Ps[b])
+			case b:
+				culprits = appendParties(culprits, Ps[a])
+			}
+		}
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(round.temp.blameCause, culprits...)
+	}
+
+	// s_j * R = V_j - l_j * G must equal m * k_j * R + r * k^-1 * (k_j * W_j + sum(mu_jl + nu_jl) * G)
+	k := big.NewInt(0)
+	for j := range Ps {
+		k = modN.Add(k, reveals[j].k)
+	}
+	if k.Sign() == 0 {
+		return round.WrapError(round.temp.blameCause)
+	}
+	kInv := modN.ModInverse(k)
+	rx := new(big.Int).Mod(round.temp.rx, q)
+	for j, Pj := range Ps {
+		rj := reveals[j]
+		sigmaJG := ecMul(ec, round.temp.bigWs[j], rj.k)
+		for l := range Ps {
+			if l == j {
+				continue
+			}
+			sigmaJG = ecAdd(ec, sigmaJG, ecAdd(ec, rj.mus[l], rj.nus[l]))
+		}
+		expected := ecAdd(ec,
+			ecMul(ec, round.temp.bigR, modN.Mul(round.temp.m, rj.k)),
+			ecMul(ec, sigmaJG, modN.Mul(rx, kInv)))
+		sR := ecAdd(ec, round.temp.bigVjs[j], ecBaseMul(ec, modN.Sub(zero, rj.l)))
+		if !sR.Equals(expected) {
+			culprits = append(culprits, Pj)
+		}
+	}
+	return round.WrapError(round.temp.blameCause, culprits...)
+}
+
+// mtaCulprits checks the MtA of Alice's k_a and Bob's gamma_b against the ciphertexts c_ab = Enc_a(k_a) sent by a in
+// round 1 and c1_ba = gamma_b * c_ab + Enc_a(beta'_ba) sent back by b in round 2, and returns the parties that lied.
+// both were sent P2P, but a broadcast their hash in round 1 and b in round 3, and the recipients checked them. every
+// party therefore checks the opened ciphertexts against the same hashes and names the same parties.
+func (round *blame) mtaCulprits(a, b int, ra, rb *blameReveal) []*tss.PartyID {
+	Ps := round.Parties().IDs()
+	q := round.EC().Params().N
+	pkA := round.key.PaillierPKs[a]
+
+	// beta_ba = -beta'_ba with beta'_ba < q^5 as chosen by an honest Bob
+	betaPrm := rb.betaPrms[a]
+	q5 := new(big.Int).Exp(q, big.NewInt(5), nil)
+	if betaPrm.Cmp(q5) >= 0 ||
+		new(big.Int).Mod(new(big.Int).Neg(betaPrm), q).Cmp(new(big.Int).Mod(rb.betas[a], q)) != 0 {
+		return []*tss.PartyID{Ps[b]}
+	}
+	cA, err := pkA.EncryptWithRandomness(ra.k, ra.kRands[b])
+	if err != nil {
+		return []*tss.PartyID{Ps[a]}
+	}
+	cBeta, err := pkA.EncryptWithRandomness(betaPrm, rb.betaRands[a])
+	if err != nil {
+		return []*tss.PartyID{Ps[b]}
+	}
+	c1, err := pkA.HomoMult(rb.gamma, cA)
+	if err == nil {
+		c1, err = pkA.HomoAdd(c1, cBeta)
+	}
+	if err != nil {
+		return []*tss.PartyID{Ps[b]}
+	}
+
+	r1msg := round.temp.signRound1Message2s[a].Content().(*SignRound1Message2)
+	if !bytes.Equal(ciphertextHash(cA), round.sentHash(r1msg.GetCHashes(), a, b)) {
+		return []*tss.PartyID{Ps[a]}
+	}
+	r3msg := round.temp.signRound3Messages[b].Content().(*SignRound3Message)
+	if !bytes.Equal(ciphertextHash(c1), round.sentHash(r3msg.GetC1Hashes(), b, a)) {
+		return []*tss.PartyID{Ps[b]}
+	}
+	// alpha_ab is c1_ba decrypted mod q. the range proofs bound k_a * gamma_b + beta'_ba below N, so that is
+	// k_a * gamma_b + beta'_ba mod q
+	alpha := new(big.Int).Mul(ra.k, rb.gamma)
+	alpha.Add(alpha, betaPrm).Mod(alpha, q)
+	if alpha.Cmp(new(big.Int).Mod(ra.alphas[b], q)) != 0 {
+		return []*tss.PartyID{Ps[a]}
+	}
+	return nil
+}
+
+// ----- //
+
+// expandInts and expandPoints turn a vector that skips index `skip` into a vector indexed by party
+
+func expandInts(in []*big.Int, skip int) []*big.Int {
+	out := make([]*big.Int, len(in)+1)
+	copy(out[:skip], in[:skip])
+	copy(out[skip+1:], in[skip:])
+	return out
+}
+
+func expandPoints(in []*crypto.ECPoint, skip int) []*crypto.ECPoint {
+	out := make([]*crypto.ECPoint, len(in)+1)
+	copy(out[:skip], in[:skip])
+	copy(out[skip+1:], in[skip:])
+	return out
+}
+
+func containsParty(ps []*tss.PartyID, p *tss.PartyID) bool {
+	for _, q := range ps {
+		if q.Index == p.Index {
+			return true
+		}
+	}
+	return false
+}
+
+// appendParties appends the parties in add that are not already in ps
+func appendParties(ps []*tss.PartyID, add ...*tss.PartyID) []*tss.PartyID {
+	for _, p := range add {
+		if !containsParty(ps, p) {
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
+
+// the helpers below use the curve directly so that the point at infinity does not cause a panic
+
+func ecAdd(ec elliptic.Curve, p, q *crypto.ECPoint) *crypto.ECPoint {
+	x, y := ec.Add(p.X(), p.Y(), q.X(), q.Y())
+	return crypto.NewECPointNoCurveCheck(ec, x, y)
+}
+
+func ecMul(ec elliptic.Curve, p *crypto.ECPoint, k *big.Int) *crypto.ECPoint {
+	x, y := ec.ScalarMult(p.X(), p.Y(), new(big.Int).Mod(k, ec.Params().N).Bytes())
+	return crypto.NewECPointNoCurveCheck(ec, x, y)
+}
+
+func ecBaseMul(ec elliptic.Curve, k *big.Int) *crypto.ECPoint {
+	x, y := ec.ScalarBaseMult(new(big.Int).Mod(k, ec.Params().N).Bytes())
+	return crypto.NewECPointNoCurveCheck(ec, x, y)
+}
@@ -0,0 +1,229 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	cmt "github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/crypto/mta"
+	"github.com/bnb-chain/tss-lib/v2/crypto/sealed"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	// CheckpointContentType identifies sealed containers holding checkpoints of ECDSA signing
+	CheckpointContentType = "ecdsa-signing-checkpoint"
+)
+
+type (
+	checkpoint struct {
+		Party    []byte
+		Messages [][][]byte
+		Temp     checkpointTemp
+	}
+
+	// w and bigWs are not saved, as they are derived from the key again when the party is resumed
+	checkpointTemp struct {
+		M, K, Theta, ThetaInverse, Sigma, KeyDerivationDelta, Gamma *big.Int
+		Cis, CisRandomness                                          []*big.Int
+		PointGamma                                                  *crypto.ECPoint
+		DeCommit                                                    cmt.HashDeCommitment
+		Betas, BetaPrms, C1jis, C1jisRandomness, C2jis, Vs          []*big.Int
+		Pi1jis                                                      []*mta.ProofBob
+		Pi2jis                                                      []*mta.ProofBobWC
+		Alphas, Us                                                  []*big.Int
+		Li, Si, Rx, Ry, Roi                                         *big.Int
+		BigR, BigAi, BigVi                                          *crypto.ECPoint
+		BigGammaJs                                                  []*crypto.ECPoint
+		DPower                                                      cmt.HashDeCommitment
+		Ui, Ti, BigV, BigA                                          *crypto.ECPoint
+		BigVjs, BigAjs                                              []*crypto.ECPoint
+		DTelda                                                      cmt.HashDeCommitment
+		BigUjs, BigTjs                                              []*crypto.ECPoint
+		BlameCause                                                  string
+		SSID                                                        []byte
+		SSIDNonce                                                   *big.Int
+	}
+)
+
+// EnableCheckpoints makes the party seal its state with `kek` and pass it to `store` once it has started each round
+// after the first, as described in tss.CheckpointFunc. The epoch in `epochs` is raised before each checkpoint, and the
+// messages of a round are not sent if that or `store` fails. Call it before Start.
+func (p *LocalParty) EnableCheckpoints(
+	kek sealed.KeyEncryptionKey,
+	epochs tss.CheckpointEpochs,
+	store func(checkpoint []byte) error,
+) {
+	tss.SetCheckpointFunc(p, p.params.Parties().IDs(), epochs, func(step int, state []byte) error {
+		// the blame phase is not saved. the session fails in any case, and a party that stops during it cannot be
+		// resumed, as the epoch has moved past the checkpoint before it
+		if p.temp.blameCause != nil {
+			return nil
+		}
+		bz, err := p.marshalCheckpoint(state)
+		if err != nil {
+			return err
+		}
+		curve, _ := tss.GetCurveName(p.params.EC())
+		md := sealed.Metadata{
+			ContentType: CheckpointContentType,
+			Curve:       curve,
+			PublicKey:   p.keys.ECDSAPub,
+			Epoch:       uint64(step),
+		}
+		container, err := sealed.Seal(bz, md, kek)
+		if err != nil {
+			return err
+		}
+		return store(container)
+	})
+}
+
+// ResumeLocalParty rebuilds a party from the latest checkpoint it saved with EnableCheckpoints and the `epochs` given
+// to it. The other arguments must be the ones the party was created with, and `keyDerivationDelta` is nil unless it was
+// created with NewLocalPartyWithKDD. A different message, key or delta is rejected so that the nonces of the session
+// are never used to sign anything else. Start fails unless the checkpoint is still the latest one and has not been
+// resumed from before. Start the party to continue the session.
+func ResumeLocalParty(
+	container []byte,
+	kek sealed.KeyEncryptionKey,
+	epochs tss.CheckpointEpochs,
+	msg *big.Int,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	keyDerivationDelta *big.Int,
+	out chan<- tss.Message,
+	end chan<- *common.SignatureData,
+) (tss.Party, error) {
+	bz, md, err := sealed.Open(container, kek)
+	if err != nil {
+		return nil, err
+	}
+	if md.ContentType != CheckpointContentType {
+		return nil, fmt.Errorf("the container holds %q, not %q", md.ContentType, CheckpointContentType)
+	}
+	if curve, _ := tss.GetCurveName(params.EC()); md.Curve != curve || md.PublicKey == nil || !md.PublicKey.Equals(key.ECDSAPub) {
+		return nil, errors.New("the checkpoint was taken with another key")
+	}
+	cp := new(checkpoint)
+	if err = json.Unmarshal(bz, cp); err != nil {
+		return nil, err
+	}
+	if cp.Temp.M == nil || msg == nil || cp.Temp.M.Cmp(msg) != 0 {
+		return nil, errors.New("the checkpoint was taken while signing another message")
+	}
+	if (cp.Temp.KeyDerivationDelta == nil) != (keyDerivationDelta == nil) ||
+		(keyDerivationDelta != nil && cp.Temp.KeyDerivationDelta.Cmp(keyDerivationDelta) != 0) {
+		return nil, errors.New("the checkpoint was taken with another key derivation delta")
+	}
+	p := NewLocalPartyWithKDD(msg, params, key, keyDerivationDelta, out, end).(*LocalParty)
+	if err = p.unmarshalCheckpoint(cp); err != nil {
+		return nil, err
+	}
+	if err = p.FirstRound().(*round1).prepare(); err != nil {
+		return nil, err
+	}
+	if err = tss.ResumeParty(p, params.Parties().IDs(), epochs, cp.Party); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ----- //
+
+// CheckpointState is inherited by other rounds
+func (round *base) CheckpointState() (int, [][]bool) {
+	return round.number, [][]bool{round.ok}
+}
+
+// RestoreState is inherited by other rounds
+func (round *base) RestoreState(number int, ok [][]bool) error {
+	if len(ok) != 1 || len(ok[0]) != len(round.ok) {
+		return errors.New("the checkpoint has a round state of an unexpected size")
+	}
+	copy(round.ok, ok[0])
+	round.number, round.started = number, true
+	return nil
+}
+
+func (s *localMessageStore) slots() []*[]tss.ParsedMessage {
+	return []*[]tss.ParsedMessage{
+		&s.signRound1Message1s, &s.signRound1Message2s, &s.signRound2Messages, &s.signRound3Messages,
+		&s.signRound4Messages, &s.signRound5Messages, &s.signRound6Messages, &s.signRound7Messages,
+		&s.signRound8Messages, &s.signRound9Messages, &s.signBlameMessages,
+	}
+}
+
+func (p *LocalParty) marshalCheckpoint(state []byte) ([]byte, error) {
+	t := &p.temp
+	cp := &checkpoint{
+		Party: state,
+		Temp: checkpointTemp{
+			M: t.m, K: t.k, Theta: t.theta, ThetaInverse: t.thetaInverse, Sigma: t.sigma,
+			KeyDerivationDelta: t.keyDerivationDelta, Gamma: t.gamma,
+			Cis: t.cis, CisRandomness: t.cisRandomness, PointGamma: t.pointGamma, DeCommit: t.deCommit,
+			BetaPrms: t.betaPrms, C1jisRandomness: t.c1jisRandomness,
+			Betas: t.betas, C1jis: t.c1jis, C2jis: t.c2jis, Vs: t.vs, Pi1jis: t.pi1jis, Pi2jis: t.pi2jis,
+			Alphas: t.alphas, Us: t.us,
+			Li: t.li, Si: t.si, Rx: t.rx, Ry: t.ry, Roi: t.roi,
+			BigR: t.bigR, BigAi: t.bigAi, BigVi: t.bigVi, BigGammaJs: t.bigGammaJs, DPower: t.DPower,
+			Ui: t.Ui, Ti: t.Ti, BigV: t.bigV, BigA: t.bigA, BigVjs: t.bigVjs, BigAjs: t.bigAjs, DTelda: t.DTelda,
+			BigUjs: t.bigUjs, BigTjs: t.bigTjs,
+			SSID: t.ssid, SSIDNonce: t.ssidNonce,
+		},
+	}
+	if t.blameCause != nil {
+		cp.Temp.BlameCause = t.blameCause.Error()
+	}
+	for _, slot := range p.temp.slots() {
+		bzs, err := tss.MarshalMessages(*slot)
+		if err != nil {
+			return nil, err
+		}
+		cp.Messages = append(cp.Messages, bzs)
+	}
+	return json.Marshal(cp)
+}
+
+func (p *LocalParty) unmarshalCheckpoint(cp *checkpoint) error {
+	slots := p.temp.slots()
+	if len(cp.Messages) != len(slots) {
+		return errors.New("the checkpoint has an unexpected number of message stores")
+	}
+	for i, slot := range slots {
+		msgs, err := tss.UnmarshalMessages(cp.Messages[i], p.params.Parties().IDs())
+		if err != nil {
+			return err
+		}
+		if len(msgs) != len(*slot) {
+			return errors.New("the checkpoint has a message store of an unexpected size")
+		}
+		*slot = msgs
+	}
+	ct, t := cp.Temp, &p.temp
+	t.k, t.theta, t.thetaInverse, t.sigma, t.gamma = ct.K, ct.Theta, ct.ThetaInverse, ct.Sigma, ct.Gamma
+	t.cis, t.cisRandomness, t.pointGamma, t.deCommit = ct.Cis, ct.CisRandomness, ct.PointGamma, ct.DeCommit
+	t.betaPrms, t.c1jisRandomness = ct.BetaPrms, ct.C1jisRandomness
+	t.betas, t.c1jis, t.c2jis, t.vs, t.pi1jis, t.pi2jis = ct.Betas, ct.C1jis, ct.C2jis, ct.Vs, ct.Pi1jis, ct.Pi2jis
+	t.alphas, t.us = ct.Alphas, ct.Us
+	t.li, t.si, t.rx, t.ry, t.roi = ct.Li, ct.Si, ct.Rx, ct.Ry, ct.Roi
+	t.bigR, t.bigAi, t.bigVi, t.bigGammaJs, t.DPower = ct.BigR, ct.BigAi, ct.BigVi, ct.BigGammaJs, ct.DPower
+	t.Ui, t.Ti, t.bigV, t.bigA, t.bigVjs, t.bigAjs, t.DTelda = ct.Ui, ct.Ti, ct.BigV, ct.BigA, ct.BigVjs, ct.BigAjs, ct.DTelda
+	t.bigUjs, t.bigTjs = ct.BigUjs, ct.BigTjs
+	t.ssid, t.ssidNonce = ct.SSID, ct.SSIDNonce
+	if ct.BlameCause != "" {
+		t.blameCause = errors.New(ct.BlameCause)
+	}
+	return nil
+}
@@ -70,25 +70,40 @@ func (round *finalization) Start() *tss.Error {
 	}
 	ok := ecdsa.Verify(&pk, round.temp.m.Bytes(), round.temp.rx, sumS)
 	if !ok {
-		return round.WrapError(fmt.Errorf("signature verification failed"))
+		if !round.IdentifiableAbort() {
+			return round.WrapError(fmt.Errorf("signature verification failed"))
+		}
+		// the s_j are public now, so only l_i and rho_i may be revealed
+		round.startBlame(fmt.Errorf("signature verification failed"), false)
+		return nil
 	}
 
-	round.end <- round.data
+	round.out.Finish(round.data)
 
 	return nil
 }
 
 func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
+	if round.temp.blameCause != nil {
+		return round.canAcceptBlame(msg)
+	}
 	// not expecting any incoming messages in this round
 	return false
 }
 
 func (round *finalization) Update() (bool, *tss.Error) {
+	if round.temp.blameCause != nil {
+		return round.updateBlame()
+	}
 	// not expecting any incoming messages in this round
 	return false, nil
 }
 
 func (round *finalization) NextRound() tss.Round {
+	if round.temp.blameCause != nil {
+		round.started = false
+		return &blame{round}
+	}
 	return nil // finished!
 }
 
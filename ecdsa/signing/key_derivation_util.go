@@ -21,7 +21,7 @@ func UpdatePublicKeyAndAdjustBigXj(keyDerivationDelta *big.Int, keys []keygen.Lo
 	for k := range keys {
 		keys[k].ECDSAPub, err = crypto.NewECPoint(ec, extendedChildPk.X, extendedChildPk.Y)
 		if err != nil {
-			common.Logger.Errorf("error creating new extended child public key")
+			common.DefaultLogger().Error("error creating new extended child public key")
 			return err
 		}
 		// Suppose X_j has shamir shares X_j0,     X_j1,     ..., X_jn
@@ -29,7 +29,7 @@ func UpdatePublicKeyAndAdjustBigXj(keyDerivationDelta *big.Int, keys []keygen.Lo
 		for j := range keys[k].BigXj {
 			keys[k].BigXj[j], err = keys[k].BigXj[j].Add(gDelta)
 			if err != nil {
-				common.Logger.Errorf("error in delta operation")
+				common.DefaultLogger().Error("error in delta operation")
 				return err
 			}
 		}
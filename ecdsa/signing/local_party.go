@@ -32,10 +32,6 @@ type (
 		keys keygen.LocalPartySaveData
 		temp localTempData
 		data *common.SignatureData
-
-		// outbound messaging
-		out chan<- tss.Message
-		end chan<- *common.SignatureData
 	}
 
 	localMessageStore struct {
@@ -48,7 +44,8 @@ type (
 		signRound6Messages,
 		signRound7Messages,
 		signRound8Messages,
-		signRound9Messages []tss.ParsedMessage
+		signRound9Messages,
+		signBlameMessages []tss.ParsedMessage
 	}
 
 	localTempData struct {
@@ -63,19 +60,26 @@ type (
 		sigma,
 		keyDerivationDelta,
 		gamma *big.Int
-		cis        []*big.Int
+		cis,
+		cisRandomness []*big.Int
 		bigWs      []*crypto.ECPoint
 		pointGamma *crypto.ECPoint
 		deCommit   cmt.HashDeCommitment
 
 		// round 2
 		betas, // return value of Bob_mid
+		betaPrms,
 		c1jis,
+		c1jisRandomness,
 		c2jis,
 		vs []*big.Int // return value of Bob_mid_wc
 		pi1jis []*mta.ProofBob
 		pi2jis []*mta.ProofBobWC
 
+		// round 3
+		alphas, // return value of Alice_end
+		us []*big.Int // return value of Alice_end_wc
+
 		// round 5
 		li,
 		si,
@@ -85,13 +89,25 @@ type (
 		bigR,
 		bigAi,
 		bigVi *crypto.ECPoint
-		DPower cmt.HashDeCommitment
+		bigGammaJs []*crypto.ECPoint
+		DPower     cmt.HashDeCommitment
 
 		// round 7
 		Ui,
-		Ti *crypto.ECPoint
+		Ti,
+		bigV,
+		bigA *crypto.ECPoint
+		bigVjs,
+		bigAjs []*crypto.ECPoint
 		DTelda cmt.HashDeCommitment
 
+		// round 9
+		bigUjs,
+		bigTjs []*crypto.ECPoint
+
+		// blame phase; set once a party has detected a failure and revealed its ephemeral values
+		blameCause error
+
 		ssidNonce *big.Int
 		ssid      []byte
 	}
@@ -117,13 +133,11 @@ func NewLocalPartyWithKDD(
 ) tss.Party {
 	partyCount := len(params.Parties().IDs())
 	p := &LocalParty{
-		BaseParty: new(tss.BaseParty),
+		BaseParty: tss.NewBaseParty(params, out, func(result interface{}) { end <- result.(*common.SignatureData) }),
 		params:    params,
 		keys:      keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs()),
 		temp:      localTempData{},
 		data:      &common.SignatureData{},
-		out:       out,
-		end:       end,
 	}
 	// msgs init
 	p.temp.signRound1Message1s = make([]tss.ParsedMessage, partyCount)
@@ -136,22 +150,53 @@ func NewLocalPartyWithKDD(
 	p.temp.signRound7Messages = make([]tss.ParsedMessage, partyCount)
 	p.temp.signRound8Messages = make([]tss.ParsedMessage, partyCount)
 	p.temp.signRound9Messages = make([]tss.ParsedMessage, partyCount)
+	p.temp.signBlameMessages = make([]tss.ParsedMessage, partyCount)
 	// temp data init
 	p.temp.keyDerivationDelta = keyDerivationDelta
 	p.temp.m = msg
 	p.temp.cis = make([]*big.Int, partyCount)
+	p.temp.cisRandomness = make([]*big.Int, partyCount)
 	p.temp.bigWs = make([]*crypto.ECPoint, partyCount)
 	p.temp.betas = make([]*big.Int, partyCount)
+	p.temp.betaPrms = make([]*big.Int, partyCount)
 	p.temp.c1jis = make([]*big.Int, partyCount)
+	p.temp.c1jisRandomness = make([]*big.Int, partyCount)
 	p.temp.c2jis = make([]*big.Int, partyCount)
 	p.temp.pi1jis = make([]*mta.ProofBob, partyCount)
 	p.temp.pi2jis = make([]*mta.ProofBobWC, partyCount)
 	p.temp.vs = make([]*big.Int, partyCount)
+	p.temp.alphas = make([]*big.Int, partyCount)
+	p.temp.us = make([]*big.Int, partyCount)
+	p.temp.bigGammaJs = make([]*crypto.ECPoint, partyCount)
+	p.temp.bigVjs = make([]*crypto.ECPoint, partyCount)
+	p.temp.bigAjs = make([]*crypto.ECPoint, partyCount)
+	p.temp.bigUjs = make([]*crypto.ECPoint, partyCount)
+	p.temp.bigTjs = make([]*crypto.ECPoint, partyCount)
 	return p
 }
 
+// NewLocalPartyFromSaveData returns a signing party for the key encoded in `saveData` by keygen.SavedKey.Marshal.
+// The curve, threshold and party IDs are read from the save data; `signers` are the parties which take part.
+func NewLocalPartyFromSaveData(
+	msg *big.Int,
+	saveData []byte,
+	signers []*tss.PartyID,
+	out chan<- tss.Message,
+	end chan<- *common.SignatureData,
+) (tss.Party, error) {
+	key, err := keygen.UnmarshalSavedKey(saveData)
+	if err != nil {
+		return nil, err
+	}
+	params, err := key.Parameters(signers...)
+	if err != nil {
+		return nil, err
+	}
+	return NewLocalParty(msg, params, key.Data, out, end), nil
+}
+
 func (p *LocalParty) FirstRound() tss.Round {
-	return newRound1(p.params, &p.keys, p.data, &p.temp, p.out, p.end)
+	return newRound1(p.params, &p.keys, p.data, &p.temp, p.Outbox())
 }
 
 func (p *LocalParty) Start() *tss.Error {
@@ -168,11 +213,15 @@ func (p *LocalParty) Start() *tss.Error {
 }
 
 func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
-	return tss.BaseUpdate(p, msg, TaskName)
+	opened, openErr := p.params.OpenMessage(msg)
+	if openErr != nil {
+		return false, p.WrapError(openErr)
+	}
+	return tss.BaseUpdate(p, opened, TaskName)
 }
 
 func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
-	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	msg, err := tss.ParseSessionWireMessage(wireBytes, from, isBroadcast, p.params.SessionID())
 	if err != nil {
 		return false, p.WrapError(err)
 	}
@@ -188,6 +237,9 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
 			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
 	}
+	if err := p.params.ValidateSessionID(msg); err != nil {
+		return false, p.WrapError(err, msg.GetFrom())
+	}
 	return true, nil
 }
 
@@ -199,7 +251,7 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	fromPIdx := msg.GetFrom().Index
 
 	// switch/case is necessary to store any messages beyond current round
-	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	// replays and equivocations are caught by tss.BaseUpdate before this point. we expect the caller to apply spoofing protection.
 	switch msg.Content().(type) {
 	case *SignRound1Message1:
 		p.temp.signRound1Message1s[fromPIdx] = msg
@@ -221,8 +273,10 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 		p.temp.signRound8Messages[fromPIdx] = msg
 	case *SignRound9Message:
 		p.temp.signRound9Messages[fromPIdx] = msg
+	case *SignBlameMessage:
+		p.temp.signBlameMessages[fromPIdx] = msg
 	default: // unrecognised message, just ignore!
-		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		p.params.Logger(TaskName, 0).Warn("unrecognised message ignored", common.StringField("msg", msg.String()))
 		return false, nil
 	}
 	return true, nil
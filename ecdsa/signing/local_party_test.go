@@ -7,18 +7,25 @@
 package signing
 
 import (
+	"bytes"
+	"context"
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
 	"runtime"
+	"sort"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/ipfs/go-log"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto/sealed"
 	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
 	"github.com/bnb-chain/tss-lib/v2/test"
 	"github.com/bnb-chain/tss-lib/v2/tss"
@@ -27,6 +34,9 @@ import (
 const (
 	testParticipants = test.TestParticipants
 	testThreshold    = test.TestThreshold
+
+	// testBlameRoundTimeout ends a blame round in which the cheater does not take part
+	testBlameRoundTimeout = 10 * time.Second
 )
 
 func setUp(level string) {
@@ -239,6 +249,226 @@ signing:
 	}
 }
 
+func TestE2EIdentifiableAbortRound9(t *testing.T) {
+	setUp("info")
+	// the cheater computes s_i over a different message, so the U == T check of round 9 fails
+	culprits := runSigningWithCheater(t, big.NewInt(43), func(cheater *tss.PartyID, msg tss.ParsedMessage) tss.ParsedMessage {
+		return msg
+	})
+	for _, c := range culprits {
+		assert.Equal(t, []int{0}, c, "only the cheater must be blamed")
+	}
+}
+
+func TestE2EIdentifiableAbortFinalization(t *testing.T) {
+	setUp("info")
+	// the cheater broadcasts a wrong s_i in round 9, so the signature verification in finalization fails
+	culprits := runSigningWithCheater(t, big.NewInt(42), func(cheater *tss.PartyID, msg tss.ParsedMessage) tss.ParsedMessage {
+		if r9msg, ok := msg.Content().(*SignRound9Message); ok {
+			return NewSignRound9Message(cheater, new(big.Int).Add(r9msg.UnmarshalS(), big.NewInt(1)))
+		}
+		return msg
+	})
+	for _, c := range culprits {
+		assert.Equal(t, []int{0}, c, "only the cheater must be blamed")
+	}
+}
+
+func TestIdentifiableAbortRequiresRoundTimeout(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(signPIDs), signPIDs[0], len(signPIDs), testThreshold)
+	params.SetIdentifiableAbort()
+	P := NewLocalParty(big.NewInt(42), params, keys[0], make(chan tss.Message, len(signPIDs)), make(chan *common.SignatureData, 1))
+	startErr := P.Start()
+	if assert.NotNil(t, startErr, "a party without a round timeout must not start") {
+		assert.Contains(t, startErr.Error(), "round timeout")
+	}
+}
+
+func TestE2EIdentifiableAbortMtADispute(t *testing.T) {
+	setUp("info")
+	// the U == T check of round 9 fails and the cheater then opens the c1 it sent to party 1 in round 2 with another
+	// beta'. beta' + q gives the same beta, but not the c1 whose hash the cheater broadcast in round 3, so party 2 can
+	// tell that the cheater lied as well as party 1 can.
+	culprits := runSigningWithCheater(t, big.NewInt(43), func(cheater *tss.PartyID, msg tss.ParsedMessage) tss.ParsedMessage {
+		bmsg, ok := msg.Content().(*SignBlameMessage)
+		if !ok || !bmsg.RevealsMtA() {
+			return msg
+		}
+		mus, err := bmsg.UnmarshalMus(tss.S256())
+		assert.NoError(t, err)
+		nus, err := bmsg.UnmarshalNus(tss.S256())
+		assert.NoError(t, err)
+		betaPrms := bmsg.UnmarshalBetaPrimes()
+		betaPrms[0] = new(big.Int).Add(betaPrms[0], tss.S256().Params().N)
+		return NewSignBlameMessage(cheater, bmsg.UnmarshalL(), bmsg.UnmarshalRho(), bmsg.UnmarshalK(), bmsg.UnmarshalGamma(),
+			bmsg.UnmarshalAlphas(), bmsg.UnmarshalBetas(), mus, nus,
+			bmsg.UnmarshalKRandomness(), betaPrms, bmsg.UnmarshalBetaRandomness())
+	})
+	assert.Equal(t, []int{0}, culprits[1], "party 1 must blame the cheater")
+	assert.Equal(t, []int{0}, culprits[2], "party 2 must blame the cheater")
+}
+
+func TestE2ECiphertextHashMismatch(t *testing.T) {
+	setUp("info")
+	// the cheater broadcasts a wrong hash of the c it sent to party 1, which names it in round 2
+	culprits := runSigningWithCheater(t, big.NewInt(42), func(cheater *tss.PartyID, msg tss.ParsedMessage) tss.ParsedMessage {
+		r1msg2, ok := msg.Content().(*SignRound1Message2)
+		if !ok {
+			return msg
+		}
+		hashes := append([][]byte(nil), r1msg2.GetCHashes()...)
+		hashes[0] = common.SHA512_256(hashes[0])
+		return NewSignRound1Message2(cheater, r1msg2.UnmarshalCommitment(), hashes)
+	})
+	assert.Equal(t, []int{0}, culprits[1], "party 1 must blame the cheater")
+}
+
+// runSigningWithCheater runs signing of the message 42 with identifiable abort enabled. the party at index 0 signs
+// `cheaterMsg` and its broadcasts are passed through `tamper`. it returns the culprits of the first error reported by
+// each honest party, keyed by the index of that party.
+func runSigningWithCheater(t *testing.T, cheaterMsg *big.Int, tamper func(cheater *tss.PartyID, msg tss.ParsedMessage) tss.ParsedMessage) map[int][]int {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	parties := make([]*LocalParty, 0, len(signPIDs))
+
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	for i := 0; i < len(signPIDs); i++ {
+		params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		params.SetIdentifiableAbort()
+		params.SetContext(context.Background(), testBlameRoundTimeout, errCh)
+
+		msg := big.NewInt(42)
+		if i == 0 {
+			msg = cheaterMsg
+		}
+		P := NewLocalParty(msg, params, keys[i], outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	cheater := signPIDs[0]
+	culprits := make(map[int][]int, len(signPIDs)-1)
+	for len(culprits) < len(signPIDs)-1 {
+		select {
+		case err := <-errCh:
+			if err.Victim() == nil || err.Victim().Index == cheater.Index {
+				continue
+			}
+			// a party may go on to report errors caused by the first one
+			if _, ok := culprits[err.Victim().Index]; ok {
+				continue
+			}
+			t.Logf("party %d reported: %s", err.Victim().Index, err)
+			idxs := make([]int, 0, len(err.Culprits()))
+			for _, c := range err.Culprits() {
+				idxs = append(idxs, c.Index)
+			}
+			culprits[err.Victim().Index] = idxs
+
+		case msg := <-outCh:
+			if msg.GetFrom().Index == cheater.Index && msg.IsBroadcast() {
+				msg = tamper(cheater, msg.(tss.ParsedMessage))
+			}
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go test.SharedPartyUpdater(P, msg, errCh)
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+
+		case <-endCh:
+			// the cheater's own view of the session is consistent, so it may finish instead of entering the blame phase.
+			// the honest parties then time out waiting for its blame message and name it as the culprit
+		}
+	}
+	return culprits
+}
+
+// signWithSeed signs with parties drawing their randomness from readers seeded with `seed`, and returns the
+// signature and the messages sent by each party, sorted as the MtA messages of a round may be sent in any order
+func signWithSeed(t *testing.T, seed string) (*common.SignatureData, [][][]byte) {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		params.SetRand(common.NewDeterministicReader([]byte(fmt.Sprintf("%s/%d", seed, i))))
+		parties = append(parties, NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh))
+	}
+	for _, P := range parties {
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	sent := make([][][]byte, len(signPIDs))
+	var sig *common.SignatureData
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			bz, _, err := msg.WireBytes()
+			assert.NoError(t, err)
+			sent[msg.GetFrom().Index] = append(sent[msg.GetFrom().Index], bz)
+			dest := msg.GetTo()
+			if dest == nil {
+				dest = signPIDs
+			}
+			for _, Pj := range dest {
+				if Pj.Index == msg.GetFrom().Index {
+					continue
+				}
+				go test.SharedPartyUpdater(parties[Pj.Index], msg, errCh)
+			}
+		case sig = <-endCh:
+			ended++
+		}
+	}
+	for _, msgs := range sent {
+		sort.Slice(msgs, func(a, b int) bool { return bytes.Compare(msgs[a], msgs[b]) < 0 })
+	}
+	return sig, sent
+}
+
+func TestE2EConcurrentWithSeededRandomness(t *testing.T) {
+	setUp("info")
+
+	sig1, sent1 := signWithSeed(t, "seed")
+	sig2, sent2 := signWithSeed(t, "seed")
+	assert.Equal(t, sig1.Signature, sig2.Signature, "the same seeds must give the same signature")
+	assert.Equal(t, sent1, sent2, "the same seeds must give the same messages")
+
+	sig3, _ := signWithSeed(t, "another seed")
+	assert.NotEqual(t, sig1.Signature, sig3.Signature)
+}
+
 func TestFillTo32BytesInPlace(t *testing.T) {
 	s := big.NewInt(123456789)
 	normalizedS := padToLengthBytesInPlace(s.Bytes(), 32)
@@ -262,3 +492,370 @@ func fillBytes(x *big.Int, buf []byte) []byte {
 	}
 	return buf
 }
+
+func TestE2EConcurrentWithCheckpoint(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	kek, err := sealed.NewAESKEK("test", make([]byte, 32))
+	assert.NoError(t, err)
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	// party 0 crashes while it saves its checkpoint in round 6, so what it sent in the round is dropped
+	errCrash := errors.New("crashed")
+	var saved []byte
+	epochs := new(test.CheckpointEpochs)
+	msg := big.NewInt(42)
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+	}
+	parties := make([]*LocalParty, 0, len(signPIDs))
+	for i := range signPIDs {
+		P := NewLocalParty(msg, newParams(i), keys[i], outCh, endCh).(*LocalParty)
+		if i == 0 {
+			P.EnableCheckpoints(kek, epochs, func(checkpoint []byte) error {
+				saved = checkpoint
+				if md, _ := sealed.ReadMetadata(checkpoint); md.Epoch == 5 {
+					return errCrash
+				}
+				return nil
+			})
+		}
+		parties = append(parties, P)
+	}
+	for _, P := range parties {
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	// the transport keeps the messages for party 0, so that they can be delivered again after it restarts
+	var toParty0 []tss.Message
+	deliver := func(j int, msg tss.Message) {
+		if j == 0 {
+			toParty0 = append(toParty0, msg)
+		}
+		go test.SharedPartyUpdater(parties[j], msg, errCh)
+	}
+
+	var sig *common.SignatureData
+	resumed := false
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-errCh:
+			if !errors.Is(err, errCrash) {
+				assert.FailNow(t, err.Error())
+			}
+			if resumed {
+				// the crashed party rejects the messages that were on their way to it
+				continue
+			}
+			resumed = true
+			P, startErr := ResumeLocalParty(saved, kek, epochs, msg, newParams(0), keys[0], big.NewInt(1), outCh, endCh)
+			assert.Error(t, startErr, "a checkpoint must not be resumed with another key derivation delta")
+			P, startErr = ResumeLocalParty(saved, kek, epochs, msg, newParams(0), keys[0], nil, outCh, endCh)
+			assert.NoError(t, startErr)
+			parties[0] = P.(*LocalParty)
+			assert.Nil(t, parties[0].Start())
+			for _, msg := range toParty0 {
+				go test.SharedPartyUpdater(parties[0], msg, errCh)
+			}
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for j := range parties {
+					if j != msg.GetFrom().Index {
+						deliver(j, msg)
+					}
+				}
+			} else {
+				deliver(dest[0].Index, msg)
+			}
+		case sig = <-endCh:
+			ended++
+		}
+	}
+	assert.True(t, resumed, "party 0 should have been resumed")
+
+	pk := ecdsa.PublicKey{
+		Curve: tss.EC(),
+		X:     keys[0].ECDSAPub.X(),
+		Y:     keys[0].ECDSAPub.Y(),
+	}
+	ok := ecdsa.Verify(&pk, msg.Bytes(), new(big.Int).SetBytes(sig.R), new(big.Int).SetBytes(sig.S))
+	assert.True(t, ok, "ecdsa verify must pass")
+}
+
+func TestE2EConcurrentWithTranscript(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	transcripts := make([]*tss.Transcript, len(signPIDs))
+	parties := make([]*LocalParty, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		transcripts[i] = tss.NewTranscript()
+		params.SetTranscript(transcripts[i])
+		parties = append(parties, NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh).(*LocalParty))
+	}
+	for _, P := range parties {
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				dest = signPIDs
+			}
+			for _, Pj := range dest {
+				if Pj.Index != msg.GetFrom().Index {
+					go test.SharedPartyUpdater(parties[Pj.Index], msg, errCh)
+				}
+			}
+		case <-endCh:
+			ended++
+		}
+	}
+	// the parties record that they finished once the update that ended them returns
+	for _, P := range parties {
+		P.WaitingFor()
+	}
+
+	// an auditor only needs the public key data, here that of another party
+	for i, tr := range transcripts {
+		finished, errEvent := tr.Outcome()
+		assert.True(t, finished)
+		assert.Nil(t, errEvent)
+		assert.Nil(t, AuditTranscript(tr, keys[(i+1)%len(keys)]), "the proofs of an honest session must be valid")
+	}
+
+	// the MtA proof that party 1 sent to party 0 is tampered with
+	bz, err := transcripts[0].Marshal()
+	assert.NoError(t, err)
+	tampered, err := tss.UnmarshalTranscript(bz)
+	assert.NoError(t, err)
+	done := false
+	for i, e := range tampered.Events {
+		if e.Kind != tss.TranscriptReceived {
+			continue
+		}
+		msg, err := tss.UnmarshalMessage(e.Message, signPIDs)
+		assert.NoError(t, err)
+		if r2msg, ok := msg.Content().(*SignRound2Message); ok && msg.GetFrom().Index == 1 && !done {
+			r2msg.C1 = new(big.Int).Add(new(big.Int).SetBytes(r2msg.C1), big.NewInt(1)).Bytes()
+			tampered.Events[i].Message, err = tss.MarshalMessage(msg)
+			assert.NoError(t, err)
+			done = true
+		}
+	}
+	assert.True(t, done)
+	tssErr := AuditTranscript(tampered, keys[0])
+	if assert.NotNil(t, tssErr) {
+		assert.Equal(t, 2, tssErr.Round())
+		assert.Equal(t, signPIDs[0].Key, tssErr.Victim().Key)
+		if assert.Len(t, tssErr.Culprits(), 1) {
+			assert.Equal(t, signPIDs[1].Key, tssErr.Culprits()[0].Key)
+		}
+	}
+}
+
+// runBatch signs `msgs` with a batch party for each of the signers; `tamper` may change a message before it is
+// delivered. It returns the signatures of party 0, or the first error.
+func runBatch(t *testing.T, keys []keygen.LocalPartySaveData, signPIDs tss.SortedPartyIDs, msgs, deltas []*big.Int,
+	tamper func(tss.Message)) ([]*common.SignatureData, *tss.Error) {
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan []*common.SignatureData, len(signPIDs))
+
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		P, err := NewBatchLocalPartyWithKDD(msgs, params, keys[i], deltas, outCh, endCh)
+		assert.NoError(t, err)
+		parties = append(parties, P)
+	}
+	for _, P := range parties {
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	var sigs []*common.SignatureData
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-errCh:
+			return nil, err
+		case msg := <-outCh:
+			if tamper != nil {
+				tamper(msg)
+			}
+			dest := msg.GetTo()
+			if dest == nil {
+				dest = signPIDs
+			}
+			for _, Pj := range dest {
+				if Pj.Index != msg.GetFrom().Index {
+					go test.SharedPartyUpdater(parties[Pj.Index], msg, errCh)
+				}
+			}
+		case data := <-endCh:
+			if sigs == nil {
+				sigs = data
+			} else {
+				for i := range data {
+					assert.Equal(t, sigs[i].Signature, data[i].Signature, "the parties must agree on signature %d", i)
+				}
+			}
+			ended++
+		}
+	}
+	return sigs, nil
+}
+
+func TestE2EConcurrentBatch(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	chainCode := make([]byte, 32)
+	fillBytes(common.GetRandomPositiveInt(new(big.Int).Lsh(big.NewInt(1), 256)), chainCode)
+	delta, childPk, err := derivingPubkeyFromPath(keys[0].ECDSAPub, chainCode, []uint32{12, 209, 3}, tss.S256())
+	assert.NoError(t, err)
+
+	// the second message is signed with a derived key
+	msgs := []*big.Int{big.NewInt(42), big.NewInt(43), big.NewInt(44)}
+	sigs, tssErr := runBatch(t, keys, signPIDs, msgs, []*big.Int{nil, delta, nil}, nil)
+	if !assert.Nil(t, tssErr) {
+		return
+	}
+	assert.Len(t, sigs, len(msgs))
+	pk := ecdsa.PublicKey{Curve: tss.EC(), X: keys[0].ECDSAPub.X(), Y: keys[0].ECDSAPub.Y()}
+	for i, sig := range sigs {
+		pk := pk
+		if i == 1 {
+			pk = childPk.PublicKey
+		}
+		assert.Equal(t, msgs[i].Bytes(), sig.M)
+		r, s := new(big.Int).SetBytes(sig.R), new(big.Int).SetBytes(sig.S)
+		assert.True(t, ecdsa.Verify(&pk, msgs[i].Bytes(), r, s), "signature %d must verify", i)
+	}
+}
+
+func TestE2EConcurrentBatchAbort(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// party 1 gives party 0 the MtA message of instance 0 in place of that of instance 1, which must not verify as
+	// the instances have different SSIDs
+	tamper := func(msg tss.Message) {
+		content, ok := msg.(tss.ParsedMessage).Content().(*SignBatchMessage)
+		if !ok || content.GetStep() != 2 || msg.GetFrom().Index != 1 || msg.GetTo()[0].Index != 0 {
+			return
+		}
+		content.Entries[1].Message = content.Entries[0].Message
+		msg.WireMsg().Message, _ = anypb.New(content)
+	}
+	msgs := []*big.Int{big.NewInt(42), big.NewInt(43)}
+	_, tssErr := runBatch(t, keys, signPIDs, msgs, nil, tamper)
+	if assert.NotNil(t, tssErr) {
+		instance, ok := BatchInstanceOf(tssErr)
+		assert.True(t, ok)
+		assert.Equal(t, 1, instance)
+		assert.Equal(t, BatchTaskName, tssErr.Task())
+		assert.Equal(t, 3, tssErr.Round())
+		// both of the MtA proofs of party 1 fail
+		if assert.NotEmpty(t, tssErr.Culprits()) {
+			for _, culprit := range tssErr.Culprits() {
+				assert.Equal(t, signPIDs[1].Key, culprit.Key)
+			}
+		}
+	}
+}
+
+func TestE2EStep(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// the parties are stepped in turn on one goroutine, with no channels to block on
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	msg := big.NewInt(42)
+	steppers := make([]*tss.StepParty, len(signPIDs))
+	inboxes := make([][]tss.ParsedMessage, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		steppers[i], err = tss.NewStepParty(NewLocalParty(msg, params, keys[i], nil, nil))
+		assert.NoError(t, err)
+	}
+	deliver := func(out tss.Message) {
+		bz, _, err := out.WireBytes()
+		assert.NoError(t, err)
+		for j, Pj := range signPIDs {
+			if j == out.GetFrom().Index || (!out.IsBroadcast() && out.GetTo()[0].Index != j) {
+				continue
+			}
+			parsed, err := tss.ParseSessionWireMessage(bz, out.GetFrom(), out.IsBroadcast(), out.WireMsg().GetSessionId())
+			assert.NoError(t, err)
+			inboxes[Pj.Index] = append(inboxes[Pj.Index], parsed)
+		}
+	}
+
+	sigs := make([]*common.SignatureData, len(signPIDs))
+	// step every party until no messages are left in flight
+	for pending := true; pending; {
+		for i, S := range steppers {
+			incoming := inboxes[i]
+			inboxes[i] = nil
+			outgoing, result, err := S.Step(incoming)
+			if !assert.Nil(t, err) {
+				return
+			}
+			for _, out := range outgoing {
+				deliver(out)
+			}
+			if result != nil {
+				sigs[i] = result.(*common.SignatureData)
+			}
+		}
+		pending = false
+		for _, inbox := range inboxes {
+			pending = pending || 0 < len(inbox)
+		}
+	}
+	pk := ecdsa.PublicKey{Curve: tss.EC(), X: keys[0].ECDSAPub.X(), Y: keys[0].ECDSAPub.Y()}
+	for i, sig := range sigs {
+		if !assert.NotNil(t, sig, "party %d must finish", i) {
+			continue
+		}
+		r, s := new(big.Int).SetBytes(sig.R), new(big.Int).SetBytes(sig.S)
+		assert.True(t, ecdsa.Verify(&pk, msg.Bytes(), r, s), "ecdsa verify must pass")
+		assert.Empty(t, steppers[i].Party().WaitingFor())
+	}
+}
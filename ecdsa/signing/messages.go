@@ -8,6 +8,7 @@ package signing
 
 import (
 	"crypto/elliptic"
+	"fmt"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/v2/common"
@@ -34,6 +35,8 @@ var (
 		(*SignRound7Message)(nil),
 		(*SignRound8Message)(nil),
 		(*SignRound9Message)(nil),
+		(*SignBlameMessage)(nil),
+		(*SignBatchMessage)(nil),
 	}
 )
 
@@ -74,9 +77,12 @@ func (m *SignRound1Message1) UnmarshalRangeProofAlice() (*mta.RangeProofAlice, e
 
 // ----- //
 
+// NewSignRound1Message2 broadcasts the commitment to Gamma_i along with cHashes, the hashes of the c sent to the
+// other parties in their order, skipping the sender
 func NewSignRound1Message2(
 	from *tss.PartyID,
 	commitment cmt.HashCommitment,
+	cHashes [][]byte,
 ) tss.ParsedMessage {
 	meta := tss.MessageRouting{
 		From:        from,
@@ -84,6 +90,7 @@ func NewSignRound1Message2(
 	}
 	content := &SignRound1Message2{
 		Commitment: commitment.Bytes(),
+		CHashes:    cHashes,
 	}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg)
@@ -91,7 +98,8 @@ func NewSignRound1Message2(
 
 func (m *SignRound1Message2) ValidateBasic() bool {
 	return m.Commitment != nil &&
-		common.NonEmptyBytes(m.GetCommitment())
+		common.NonEmptyBytes(m.GetCommitment()) &&
+		common.NonEmptyMultiBytes(m.GetCHashes())
 }
 
 func (m *SignRound1Message2) UnmarshalCommitment() *big.Int {
@@ -142,16 +150,20 @@ func (m *SignRound2Message) UnmarshalProofBobWC(ec elliptic.Curve) (*mta.ProofBo
 
 // ----- //
 
+// NewSignRound3Message broadcasts delta_i along with c1Hashes, the hashes of the c1 sent to the other parties in
+// round 2 in their order, skipping the sender
 func NewSignRound3Message(
 	from *tss.PartyID,
 	theta *big.Int,
+	c1Hashes [][]byte,
 ) tss.ParsedMessage {
 	meta := tss.MessageRouting{
 		From:        from,
 		IsBroadcast: true,
 	}
 	content := &SignRound3Message{
-		Theta: theta.Bytes(),
+		Theta:    theta.Bytes(),
+		C1Hashes: c1Hashes,
 	}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg)
@@ -159,7 +171,8 @@ func NewSignRound3Message(
 
 func (m *SignRound3Message) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyBytes(m.Theta)
+		common.NonEmptyBytes(m.Theta) &&
+		common.NonEmptyMultiBytes(m.GetC1Hashes())
 }
 
 // ----- //
@@ -389,3 +402,155 @@ func (m *SignRound9Message) ValidateBasic() bool {
 func (m *SignRound9Message) UnmarshalS() *big.Int {
 	return new(big.Int).SetBytes(m.S)
 }
+
+// ----- //
+
+// NewSignBlameMessage reveals the ephemeral values of the sender. When k is nil only l and rho are revealed,
+// which is used when the s_i have already been broadcast and revealing k_i would leak the secret key. Otherwise
+// kRands, betaPrms and betaRands open the ciphertexts of the MtA that the sender sent, so that the alphas and betas
+// may be checked against the hashes of those ciphertexts broadcast in rounds 1 and 3.
+func NewSignBlameMessage(
+	from *tss.PartyID,
+	l, rho *big.Int,
+	k, gamma *big.Int,
+	alphas, betas []*big.Int,
+	mus, nus []*crypto.ECPoint,
+	kRands, betaPrms, betaRands []*big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &SignBlameMessage{
+		L:   l.Bytes(),
+		Rho: rho.Bytes(),
+	}
+	if k != nil {
+		content.K = k.Bytes()
+		content.Gamma = gamma.Bytes()
+		content.Alphas = common.BigIntsToBytes(alphas)
+		content.Betas = common.BigIntsToBytes(betas)
+		content.Mus = make([][]byte, 0, len(mus)*2)
+		for _, mu := range mus {
+			content.Mus = append(content.Mus, mu.X().Bytes(), mu.Y().Bytes())
+		}
+		content.Nus = make([][]byte, 0, len(nus)*2)
+		for _, nu := range nus {
+			content.Nus = append(content.Nus, nu.X().Bytes(), nu.Y().Bytes())
+		}
+		content.KRandomness = common.BigIntsToBytes(kRands)
+		content.BetaPrimes = common.BigIntsToBytes(betaPrms)
+		content.BetaRandomness = common.BigIntsToBytes(betaRands)
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignBlameMessage) ValidateBasic() bool {
+	if m == nil ||
+		!common.NonEmptyBytes(m.GetL()) ||
+		!common.NonEmptyBytes(m.GetRho()) {
+		return false
+	}
+	if !m.RevealsMtA() {
+		return true
+	}
+	return common.NonEmptyBytes(m.GetGamma()) &&
+		common.NonEmptyMultiBytes(m.GetAlphas()) &&
+		common.NonEmptyMultiBytes(m.GetBetas(), len(m.GetAlphas())) &&
+		common.NonEmptyMultiBytes(m.GetMus(), 2*len(m.GetAlphas())) &&
+		common.NonEmptyMultiBytes(m.GetNus(), 2*len(m.GetAlphas())) &&
+		common.NonEmptyMultiBytes(m.GetKRandomness(), len(m.GetAlphas())) &&
+		common.NonEmptyMultiBytes(m.GetBetaPrimes(), len(m.GetAlphas())) &&
+		common.NonEmptyMultiBytes(m.GetBetaRandomness(), len(m.GetAlphas()))
+}
+
+// RevealsMtA returns true when the sender revealed k_i, gamma_i and its MtA shares
+func (m *SignBlameMessage) RevealsMtA() bool {
+	return common.NonEmptyBytes(m.GetK())
+}
+
+func (m *SignBlameMessage) UnmarshalL() *big.Int {
+	return new(big.Int).SetBytes(m.GetL())
+}
+
+func (m *SignBlameMessage) UnmarshalRho() *big.Int {
+	return new(big.Int).SetBytes(m.GetRho())
+}
+
+func (m *SignBlameMessage) UnmarshalK() *big.Int {
+	return new(big.Int).SetBytes(m.GetK())
+}
+
+func (m *SignBlameMessage) UnmarshalGamma() *big.Int {
+	return new(big.Int).SetBytes(m.GetGamma())
+}
+
+func (m *SignBlameMessage) UnmarshalAlphas() []*big.Int {
+	return common.MultiBytesToBigInts(m.GetAlphas())
+}
+
+func (m *SignBlameMessage) UnmarshalBetas() []*big.Int {
+	return common.MultiBytesToBigInts(m.GetBetas())
+}
+
+func (m *SignBlameMessage) UnmarshalKRandomness() []*big.Int {
+	return common.MultiBytesToBigInts(m.GetKRandomness())
+}
+
+func (m *SignBlameMessage) UnmarshalBetaPrimes() []*big.Int {
+	return common.MultiBytesToBigInts(m.GetBetaPrimes())
+}
+
+func (m *SignBlameMessage) UnmarshalBetaRandomness() []*big.Int {
+	return common.MultiBytesToBigInts(m.GetBetaRandomness())
+}
+
+func (m *SignBlameMessage) UnmarshalMus(ec elliptic.Curve) ([]*crypto.ECPoint, error) {
+	return crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetMus()))
+}
+
+func (m *SignBlameMessage) UnmarshalNus(ec elliptic.Curve) ([]*crypto.ECPoint, error) {
+	return crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetNus()))
+}
+
+// ----- //
+
+// NewSignBatchMessage bundles the messages of the instances of a batch that are sent to `to` in `step`. A nil `to`
+// makes a broadcast.
+func NewSignBatchMessage(
+	from *tss.PartyID,
+	to []*tss.PartyID,
+	step int,
+	entries []*SignBatchMessage_Entry,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          to,
+		IsBroadcast: to == nil,
+	}
+	content := &SignBatchMessage{
+		Step:      uint32(step),
+		Broadcast: to == nil,
+		Entries:   entries,
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignBatchMessage) ValidateBasic() bool {
+	if m == nil || m.GetStep() < 1 || len(m.GetEntries()) == 0 {
+		return false
+	}
+	for _, entry := range m.GetEntries() {
+		if entry == nil || entry.GetMessage() == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Slot tells apart the bundles of a party, which sends at most one broadcast and one to each peer in a step
+func (m *SignBatchMessage) Slot() string {
+	return fmt.Sprintf("%d/%t", m.GetStep(), m.GetBroadcast())
+}
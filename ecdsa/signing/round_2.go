@@ -7,12 +7,15 @@
 package signing
 
 import (
+	"bytes"
 	"errors"
 	"math/big"
 	"sync"
+	"time"
 
 	errorspkg "github.com/pkg/errors"
 
+	"github.com/bnb-chain/tss-lib/v2/common"
 	"github.com/bnb-chain/tss-lib/v2/crypto/mta"
 	"github.com/bnb-chain/tss-lib/v2/tss"
 )
@@ -28,10 +31,31 @@ func (round *round2) Start() *tss.Error {
 	i := round.PartyID().Index
 	round.ok[i] = true
 
+	// each c received must be the one that its sender committed to in its broadcast
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
+	for j, Pj := range round.Parties().IDs() {
+		if j == i {
+			continue
+		}
+		r1msg1 := round.temp.signRound1Message1s[j].Content().(*SignRound1Message1)
+		r1msg2 := round.temp.signRound1Message2s[j].Content().(*SignRound1Message2)
+		if !bytes.Equal(ciphertextHash(r1msg1.UnmarshalC()), round.sentHash(r1msg2.GetCHashes(), j, i)) {
+			culprits = append(culprits, Pj)
+		}
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(errors.New("the c received does not match the hash broadcast by its sender"), culprits...)
+	}
+
 	errChs := make(chan *tss.Error, (len(round.Parties().IDs())-1)*2)
 	wg := sync.WaitGroup{}
 	wg.Add((len(round.Parties().IDs()) - 1) * 2)
 	ContextI := append(round.temp.ssid, new(big.Int).SetUint64(uint64(i)).Bytes()...)
+	// each goroutine draws from its own reader so that the randomness does not depend on scheduling
+	rands, err := common.SplitRandom(round.Rand(), len(round.Parties().IDs())*2)
+	if err != nil {
+		return round.WrapError(err)
+	}
 	for j, Pj := range round.Parties().IDs() {
 		if j == i {
 			continue
@@ -39,13 +63,18 @@ func (round *round2) Start() *tss.Error {
 		// Bob_mid
 		go func(j int, Pj *tss.PartyID) {
 			defer wg.Done()
+			if round.Context().Err() != nil {
+				return
+			}
 			r1msg := round.temp.signRound1Message1s[j].Content().(*SignRound1Message1)
 			rangeProofAliceJ, err := r1msg.UnmarshalRangeProofAlice()
 			if err != nil {
 				errChs <- round.WrapError(errorspkg.Wrapf(err, "UnmarshalRangeProofAlice failed"), Pj)
 				return
 			}
-			beta, c1ji, _, pi1ji, err := mta.BobMid(
+			start := time.Now()
+			beta, c1ji, betaPrm, c1jiRand, pi1ji, err := mta.BobMidAndReturnRandomnessWithRand(
+				rands[2*j],
 				ContextI,
 				round.Parameters.EC(),
 				round.key.PaillierPKs[j],
@@ -58,9 +87,14 @@ func (round *round2) Start() *tss.Error {
 				round.key.NTildej[i],
 				round.key.H1j[i],
 				round.key.H2j[i])
+			if err == nil {
+				round.ObserveProofGenerated(tss.ProofMtA, start)
+			}
 			// should be thread safe as these are pre-allocated
 			round.temp.betas[j] = beta
+			round.temp.betaPrms[j] = betaPrm
 			round.temp.c1jis[j] = c1ji
+			round.temp.c1jisRandomness[j] = c1jiRand
 			round.temp.pi1jis[j] = pi1ji
 			if err != nil {
 				errChs <- round.WrapError(err, Pj)
@@ -69,13 +103,18 @@ func (round *round2) Start() *tss.Error {
 		// Bob_mid_wc
 		go func(j int, Pj *tss.PartyID) {
 			defer wg.Done()
+			if round.Context().Err() != nil {
+				return
+			}
 			r1msg := round.temp.signRound1Message1s[j].Content().(*SignRound1Message1)
 			rangeProofAliceJ, err := r1msg.UnmarshalRangeProofAlice()
 			if err != nil {
 				errChs <- round.WrapError(errorspkg.Wrapf(err, "UnmarshalRangeProofAlice failed"), Pj)
 				return
 			}
-			v, c2ji, _, pi2ji, err := mta.BobMidWC(
+			start := time.Now()
+			v, c2ji, _, pi2ji, err := mta.BobMidWCWithRand(
+				rands[2*j+1],
 				ContextI,
 				round.Parameters.EC(),
 				round.key.PaillierPKs[j],
@@ -89,6 +128,9 @@ func (round *round2) Start() *tss.Error {
 				round.key.H1j[i],
 				round.key.H2j[i],
 				round.temp.bigWs[i])
+			if err == nil {
+				round.ObserveProofGenerated(tss.ProofMtAWC, start)
+			}
 			round.temp.vs[j] = v
 			round.temp.c2jis[j] = c2ji
 			round.temp.pi2jis[j] = pi2ji
@@ -100,7 +142,10 @@ func (round *round2) Start() *tss.Error {
 	// consume error channels; wait for goroutines
 	wg.Wait()
 	close(errChs)
-	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
+	// the goroutines skip their work once the party has been aborted
+	if err := round.Context().Err(); err != nil {
+		return round.WrapError(err)
+	}
 	for err := range errChs {
 		culprits = append(culprits, err.Culprits()...)
 	}
@@ -114,7 +159,7 @@ func (round *round2) Start() *tss.Error {
 		}
 		r2msg := NewSignRound2Message(
 			Pj, round.PartyID(), round.temp.c1jis[j], round.temp.pi1jis[j], round.temp.c2jis[j], round.temp.pi2jis[j])
-		round.out <- r2msg
+		round.out.Send(r2msg)
 	}
 	return nil
 }
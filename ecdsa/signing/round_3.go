@@ -10,6 +10,7 @@ import (
 	"errors"
 	"math/big"
 	"sync"
+	"time"
 
 	errorspkg "github.com/pkg/errors"
 
@@ -26,9 +27,6 @@ func (round *round3) Start() *tss.Error {
 	round.started = true
 	round.resetOK()
 
-	var alphas = make([]*big.Int, len(round.Parties().IDs()))
-	var us = make([]*big.Int, len(round.Parties().IDs()))
-
 	i := round.PartyID().Index
 
 	errChs := make(chan *tss.Error, (len(round.Parties().IDs())-1)*2)
@@ -42,12 +40,16 @@ func (round *round3) Start() *tss.Error {
 		// Alice_end
 		go func(j int, Pj *tss.PartyID) {
 			defer wg.Done()
+			if round.Context().Err() != nil {
+				return
+			}
 			r2msg := round.temp.signRound2Messages[j].Content().(*SignRound2Message)
 			proofBob, err := r2msg.UnmarshalProofBob()
 			if err != nil {
 				errChs <- round.WrapError(errorspkg.Wrapf(err, "UnmarshalProofBob failed"), Pj)
 				return
 			}
+			start := time.Now()
 			alphaIj, err := mta.AliceEnd(
 				ContextJ,
 				round.Params().EC(),
@@ -59,7 +61,8 @@ func (round *round3) Start() *tss.Error {
 				new(big.Int).SetBytes(r2msg.GetC1()),
 				round.key.NTildej[i],
 				round.key.PaillierSK)
-			alphas[j] = alphaIj
+			round.ObserveProofVerified(tss.ProofMtA, err == nil, start)
+			round.temp.alphas[j] = alphaIj
 			if err != nil {
 				errChs <- round.WrapError(err, Pj)
 			}
@@ -67,12 +70,16 @@ func (round *round3) Start() *tss.Error {
 		// Alice_end_wc
 		go func(j int, Pj *tss.PartyID) {
 			defer wg.Done()
+			if round.Context().Err() != nil {
+				return
+			}
 			r2msg := round.temp.signRound2Messages[j].Content().(*SignRound2Message)
 			proofBobWC, err := r2msg.UnmarshalProofBobWC(round.Parameters.EC())
 			if err != nil {
 				errChs <- round.WrapError(errorspkg.Wrapf(err, "UnmarshalProofBobWC failed"), Pj)
 				return
 			}
+			start := time.Now()
 			uIj, err := mta.AliceEndWC(
 				ContextJ,
 				round.Params().EC(),
@@ -85,7 +92,8 @@ func (round *round3) Start() *tss.Error {
 				round.key.H1j[i],
 				round.key.H2j[i],
 				round.key.PaillierSK)
-			us[j] = uIj
+			round.ObserveProofVerified(tss.ProofMtAWC, err == nil, start)
+			round.temp.us[j] = uIj
 			if err != nil {
 				errChs <- round.WrapError(err, Pj)
 			}
@@ -95,6 +103,10 @@ func (round *round3) Start() *tss.Error {
 	// consume error channels; wait for goroutines
 	wg.Wait()
 	close(errChs)
+	// the goroutines skip their work once the party has been aborted
+	if err := round.Context().Err(); err != nil {
+		return round.WrapError(err)
+	}
 	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
 	for err := range errChs {
 		culprits = append(culprits, err.Culprits()...)
@@ -111,15 +123,15 @@ func (round *round3) Start() *tss.Error {
 		if j == round.PartyID().Index {
 			continue
 		}
-		thelta = modN.Add(thelta, alphas[j].Add(alphas[j], round.temp.betas[j]))
-		sigma = modN.Add(sigma, us[j].Add(us[j], round.temp.vs[j]))
+		thelta = modN.Add(thelta, modN.Add(round.temp.alphas[j], round.temp.betas[j]))
+		sigma = modN.Add(sigma, modN.Add(round.temp.us[j], round.temp.vs[j]))
 	}
 
 	round.temp.theta = thelta
 	round.temp.sigma = sigma
-	r3msg := NewSignRound3Message(round.PartyID(), thelta)
+	r3msg := NewSignRound3Message(round.PartyID(), thelta, round.ciphertextHashes(round.temp.c1jis))
 	round.temp.signRound3Messages[round.PartyID().Index] = r3msg
-	round.out <- r3msg
+	round.out.Send(r3msg)
 
 	return nil
 }
@@ -7,8 +7,10 @@
 package signing
 
 import (
+	"bytes"
 	"errors"
 	"math/big"
+	"time"
 
 	errors2 "github.com/pkg/errors"
 
@@ -25,6 +27,23 @@ func (round *round4) Start() *tss.Error {
 	round.started = true
 	round.resetOK()
 
+	// each c1 received in round 2 must be the one that its sender committed to in its broadcast
+	i := round.PartyID().Index
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
+	for j, Pj := range round.Parties().IDs() {
+		if j == i {
+			continue
+		}
+		r2msg := round.temp.signRound2Messages[j].Content().(*SignRound2Message)
+		r3msg := round.temp.signRound3Messages[j].Content().(*SignRound3Message)
+		if !bytes.Equal(ciphertextHash(new(big.Int).SetBytes(r2msg.GetC1())), round.sentHash(r3msg.GetC1Hashes(), j, i)) {
+			culprits = append(culprits, Pj)
+		}
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(errors.New("the c1 received does not match the hash broadcast by its sender"), culprits...)
+	}
+
 	theta := *round.temp.theta
 	thetaInverse := &theta
 
@@ -41,16 +60,17 @@ func (round *round4) Start() *tss.Error {
 
 	// compute the multiplicative inverse thelta mod q
 	thetaInverse = modN.ModInverse(thetaInverse)
-	i := round.PartyID().Index
 	ContextI := append(round.temp.ssid, new(big.Int).SetUint64(uint64(i)).Bytes()...)
-	piGamma, err := schnorr.NewZKProof(ContextI, round.temp.gamma, round.temp.pointGamma)
+	start := time.Now()
+	piGamma, err := schnorr.NewZKProofWithRand(round.Rand(), ContextI, round.temp.gamma, round.temp.pointGamma)
 	if err != nil {
 		return round.WrapError(errors2.Wrapf(err, "NewZKProof(gamma, bigGamma)"))
 	}
+	round.ObserveProofGenerated(tss.ProofSchnorr, start)
 	round.temp.thetaInverse = thetaInverse
 	r4msg := NewSignRound4Message(round.PartyID(), round.temp.deCommit, piGamma)
 	round.temp.signRound4Messages[round.PartyID().Index] = r4msg
-	round.out <- r4msg
+	round.out.Send(r4msg)
 
 	return nil
 }
@@ -9,6 +9,7 @@ package signing
 import (
 	"errors"
 	"math/big"
+	"time"
 
 	errors2 "github.com/pkg/errors"
 
@@ -27,6 +28,7 @@ func (round *round5) Start() *tss.Error {
 	round.resetOK()
 
 	R := round.temp.pointGamma
+	round.temp.bigGammaJs[round.PartyID().Index] = round.temp.pointGamma
 	for j, Pj := range round.Parties().IDs() {
 		if j == round.PartyID().Index {
 			continue
@@ -48,10 +50,13 @@ func (round *round5) Start() *tss.Error {
 		if err != nil {
 			return round.WrapError(errors.New("failed to unmarshal bigGamma proof"), Pj)
 		}
+		start := time.Now()
 		ok = proof.Verify(ContextJ, bigGammaJPoint)
+		round.ObserveProofVerified(tss.ProofSchnorr, ok, start)
 		if !ok {
 			return round.WrapError(errors.New("failed to prove bigGamma"), Pj)
 		}
+		round.temp.bigGammaJs[j] = bigGammaJPoint
 		R, err = R.Add(bigGammaJPoint)
 		if err != nil {
 			return round.WrapError(errors2.Wrapf(err, "R.Add(bigGammaJ)"), Pj)
@@ -66,11 +71,14 @@ func (round *round5) Start() *tss.Error {
 	si := modN.Add(modN.Mul(round.temp.m, round.temp.k), modN.Mul(rx, round.temp.sigma))
 
 	// clear temp.w and temp.k from memory, lint ignore
+	// k is kept until the end of the session when it may have to be revealed in the blame phase
 	round.temp.w = zero
-	round.temp.k = zero
+	if !round.IdentifiableAbort() {
+		round.temp.k = zero
+	}
 
-	li := common.GetRandomPositiveInt(N)  // li
-	roI := common.GetRandomPositiveInt(N) // pi
+	li := common.GetRandomPositiveIntWithRand(round.Rand(), N)  // li
+	roI := common.GetRandomPositiveIntWithRand(round.Rand(), N) // pi
 	rToSi := R.ScalarMult(si)
 	liPoint := crypto.ScalarBaseMult(round.Params().EC(), li)
 	bigAi := crypto.ScalarBaseMult(round.Params().EC(), roI)
@@ -79,10 +87,10 @@ func (round *round5) Start() *tss.Error {
 		return round.WrapError(errors2.Wrapf(err, "rToSi.Add(li)"))
 	}
 
-	cmt := commitments.NewHashCommitment(bigVi.X(), bigVi.Y(), bigAi.X(), bigAi.Y())
+	cmt := commitments.NewHashCommitmentWithRand(round.Rand(), bigVi.X(), bigVi.Y(), bigAi.X(), bigAi.Y())
 	r5msg := NewSignRound5Message(round.PartyID(), cmt.C)
 	round.temp.signRound5Messages[round.PartyID().Index] = r5msg
-	round.out <- r5msg
+	round.out.Send(r5msg)
 
 	round.temp.li = li
 	round.temp.bigAi = bigAi
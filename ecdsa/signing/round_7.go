@@ -9,6 +9,7 @@ package signing
 import (
 	"errors"
 	"math/big"
+	"time"
 
 	errors2 "github.com/pkg/errors"
 
@@ -26,8 +27,10 @@ func (round *round7) Start() *tss.Error {
 	round.started = true
 	round.resetOK()
 
-	bigVjs := make([]*crypto.ECPoint, len(round.Parties().IDs()))
-	bigAjs := make([]*crypto.ECPoint, len(round.Parties().IDs()))
+	bigVjs := round.temp.bigVjs
+	bigAjs := round.temp.bigAjs
+	bigVjs[round.PartyID().Index] = round.temp.bigVi
+	bigAjs[round.PartyID().Index] = round.temp.bigAi
 	for j, Pj := range round.Parties().IDs() {
 		if j == round.PartyID().Index {
 			continue
@@ -52,12 +55,18 @@ func (round *round7) Start() *tss.Error {
 			return round.WrapError(errors2.Wrapf(err, "NewECPoint(bigAj)"), Pj)
 		}
 		bigAjs[j] = bigAj
+		start := time.Now()
 		pijA, err := r6msg.UnmarshalZKProof(round.Params().EC())
-		if err != nil || !pijA.Verify(ContextJ, bigAj) {
+		ok = err == nil && pijA.Verify(ContextJ, bigAj)
+		round.ObserveProofVerified(tss.ProofSchnorr, ok, start)
+		if !ok {
 			return round.WrapError(errors.New("schnorr verify for Aj failed"), Pj)
 		}
+		start = time.Now()
 		pijV, err := r6msg.UnmarshalZKVProof(round.Params().EC())
-		if err != nil || !pijV.Verify(ContextJ, bigVj, round.temp.bigR) {
+		ok = err == nil && pijV.Verify(ContextJ, bigVj, round.temp.bigR)
+		round.ObserveProofVerified(tss.ProofSchnorrV, ok, start)
+		if !ok {
 			return round.WrapError(errors.New("vverify for Vj failed"), Pj)
 		}
 	}
@@ -79,14 +88,16 @@ func (round *round7) Start() *tss.Error {
 		AX, AY = round.Params().EC().Add(AX, AY, bigAjs[j].X(), bigAjs[j].Y())
 	}
 
+	round.temp.bigV = crypto.NewECPointNoCurveCheck(round.Params().EC(), VX, VY)
+	round.temp.bigA = crypto.NewECPointNoCurveCheck(round.Params().EC(), AX, AY)
 	UiX, UiY := round.Params().EC().ScalarMult(VX, VY, round.temp.roi.Bytes())
 	TiX, TiY := round.Params().EC().ScalarMult(AX, AY, round.temp.li.Bytes())
 	round.temp.Ui = crypto.NewECPointNoCurveCheck(round.Params().EC(), UiX, UiY)
 	round.temp.Ti = crypto.NewECPointNoCurveCheck(round.Params().EC(), TiX, TiY)
-	cmt := commitments.NewHashCommitment(UiX, UiY, TiX, TiY)
+	cmt := commitments.NewHashCommitmentWithRand(round.Rand(), UiX, UiY, TiX, TiY)
 	r7msg := NewSignRound7Message(round.PartyID(), cmt.C)
 	round.temp.signRound7Messages[round.PartyID().Index] = r7msg
-	round.out <- r7msg
+	round.out.Send(r7msg)
 	round.temp.DTelda = cmt.D
 
 	return nil
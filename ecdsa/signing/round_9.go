@@ -9,6 +9,7 @@ package signing
 import (
 	"errors"
 
+	"github.com/bnb-chain/tss-lib/v2/crypto"
 	"github.com/bnb-chain/tss-lib/v2/crypto/commitments"
 	"github.com/bnb-chain/tss-lib/v2/tss"
 )
@@ -21,6 +22,8 @@ func (round *round9) Start() *tss.Error {
 	round.started = true
 	round.resetOK()
 
+	round.temp.bigUjs[round.PartyID().Index] = round.temp.Ui
+	round.temp.bigTjs[round.PartyID().Index] = round.temp.Ti
 	UX, UY := round.temp.Ui.X(), round.temp.Ui.Y()
 	TX, TY := round.temp.Ti.X(), round.temp.Ti.Y()
 	for j, Pj := range round.Parties().IDs() {
@@ -33,24 +36,34 @@ func (round *round9) Start() *tss.Error {
 		cj, dj := r7msg.UnmarshalCommitment(), r8msg.UnmarshalDeCommitment()
 		cmt := commitments.HashCommitDecommit{C: cj, D: dj}
 		ok, values := cmt.DeCommit()
-		if !ok && len(values) != 4 {
+		if !ok || len(values) != 4 {
 			return round.WrapError(errors.New("de-commitment for bigVj and bigAj failed"), Pj)
 		}
 		UjX, UjY, TjX, TjY := values[0], values[1], values[2], values[3]
+		round.temp.bigUjs[j] = crypto.NewECPointNoCurveCheck(round.Params().EC(), UjX, UjY)
+		round.temp.bigTjs[j] = crypto.NewECPointNoCurveCheck(round.Params().EC(), TjX, TjY)
 		UX, UY = round.Params().EC().Add(UX, UY, UjX, UjY)
 		TX, TY = round.Params().EC().Add(TX, TY, TjX, TjY)
 	}
 	if UX.Cmp(TX) != 0 || UY.Cmp(TY) != 0 {
-		return round.WrapError(errors.New("U doesn't equal T"), round.PartyID())
+		if !round.IdentifiableAbort() {
+			return round.WrapError(errors.New("U doesn't equal T"), round.PartyID())
+		}
+		// s_i must not be broadcast now; reveal the ephemeral values instead so that the cheater can be found
+		round.startBlame(errors.New("U doesn't equal T"), true)
+		return nil
 	}
 
 	r9msg := NewSignRound9Message(round.PartyID(), round.temp.si)
 	round.temp.signRound9Messages[round.PartyID().Index] = r9msg
-	round.out <- r9msg
+	round.out.Send(r9msg)
 	return nil
 }
 
 func (round *round9) Update() (bool, *tss.Error) {
+	if round.temp.blameCause != nil {
+		return round.updateBlame()
+	}
 	for j, msg := range round.temp.signRound9Messages {
 		if round.ok[j] {
 			continue
@@ -64,6 +77,9 @@ func (round *round9) Update() (bool, *tss.Error) {
 }
 
 func (round *round9) CanAccept(msg tss.ParsedMessage) bool {
+	if round.temp.blameCause != nil {
+		return round.canAcceptBlame(msg)
+	}
 	if _, ok := msg.Content().(*SignRound9Message); ok {
 		return msg.IsBroadcast()
 	}
@@ -72,5 +88,8 @@ func (round *round9) CanAccept(msg tss.ParsedMessage) bool {
 
 func (round *round9) NextRound() tss.Round {
 	round.started = false
+	if round.temp.blameCause != nil {
+		return &blame{&finalization{round}}
+	}
 	return &finalization{round}
 }
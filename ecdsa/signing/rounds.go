@@ -26,8 +26,7 @@ type (
 		key     *keygen.LocalPartySaveData
 		data    *common.SignatureData
 		temp    *localTempData
-		out     chan<- tss.Message
-		end     chan<- *common.SignatureData
+		out     *tss.Outbox
 		ok      []bool // `ok` tracks parties which have been verified by Update()
 		started bool
 		number  int
@@ -62,6 +61,9 @@ type (
 	finalization struct {
 		*round9
 	}
+	blame struct {
+		*finalization
+	}
 )
 
 var (
@@ -75,6 +77,7 @@ var (
 	_ tss.Round = (*round8)(nil)
 	_ tss.Round = (*round9)(nil)
 	_ tss.Round = (*finalization)(nil)
+	_ tss.Round = (*blame)(nil)
 )
 
 // ----- //
@@ -144,3 +147,33 @@ func (round *base) getSSID() ([]byte, error) {
 
 	return ssid, nil
 }
+
+// ciphertextHash is broadcast for each MtA ciphertext sent P2P, so that every party can check the ciphertext once it
+// is opened in the blame phase
+func ciphertextHash(c *big.Int) []byte {
+	return common.SHA512_256(c.Bytes())
+}
+
+// ciphertextHashes returns the hashes of `cs`, the ciphertexts sent to each party, skipping this party
+func (round *base) ciphertextHashes(cs []*big.Int) [][]byte {
+	i := round.PartyID().Index
+	hashes := make([][]byte, 0, len(cs)-1)
+	for j, c := range cs {
+		if j == i {
+			continue
+		}
+		hashes = append(hashes, ciphertextHash(c))
+	}
+	return hashes
+}
+
+// sentHash returns the hash in `hashes`, broadcast by party `from`, of the ciphertext it sent to party `to`, or nil
+func (round *base) sentHash(hashes [][]byte, from, to int) []byte {
+	if len(hashes) != len(round.Parties().IDs())-1 || from == to {
+		return nil
+	}
+	if to > from {
+		to--
+	}
+	return hashes[to]
+}
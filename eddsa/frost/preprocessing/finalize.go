@@ -0,0 +1,77 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package preprocessing
+
+import (
+	"errors"
+	"fmt"
+
+	errors2 "github.com/pkg/errors"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func (round *finalization) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	bigDs := make([][]*crypto.ECPoint, len(Ps))
+	bigEs := make([][]*crypto.ECPoint, len(Ps))
+	culprits := make([]*tss.PartyID, 0, len(Ps))
+	for j, Pj := range Ps {
+		round.ok[j] = true
+		r1msg := round.temp.preprocessRound1Messages[j].Content().(*PreprocessRound1Message)
+		bigDj, bigEj, err := r1msg.UnmarshalCommitments(round.Params().EC())
+		if err != nil {
+			return round.WrapError(errors2.Wrapf(err, "UnmarshalCommitments"), Pj)
+		}
+		if len(bigDj) != round.temp.batchSize {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		for l := range bigDj {
+			if !isValidCommitment(bigDj[l]) || !isValidCommitment(bigEj[l]) {
+				culprits = append(culprits, Pj)
+				break
+			}
+		}
+		bigDs[j], bigEs[j] = bigDj, bigEj
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(fmt.Errorf("invalid nonce commitments received from %d parties", len(culprits)), culprits...)
+	}
+
+	round.data.HidingNonces = round.temp.ds
+	round.data.BindingNonces = round.temp.es
+	round.data.HidingCommitments = bigDs
+	round.data.BindingCommitments = bigEs
+	round.data.Ks = round.key.Ks
+
+	round.out.Finish(round.data)
+
+	return nil
+}
+
+func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *finalization) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *finalization) NextRound() tss.Round {
+	return nil // finished!
+}
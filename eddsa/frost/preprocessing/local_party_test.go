@@ -0,0 +1,103 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package preprocessing
+
+import (
+	"testing"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/test"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+	testBatchSize    = 4
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+
+	// only for test
+	tss.SetCurve(tss.Edwards())
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// PHASE: preprocessing
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *NonceBatch, len(signPIDs))
+
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		parties = append(parties, NewLocalParty(params, keys[i], testBatchSize, outCh, endCh))
+	}
+	for _, P := range parties {
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	batches := make([]*NonceBatch, 0, len(signPIDs))
+preprocessing:
+	for {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			for _, P := range parties {
+				if P.PartyID().Index == msg.GetFrom().Index {
+					continue
+				}
+				go test.SharedPartyUpdater(P, msg, errCh)
+			}
+		case batch := <-endCh:
+			batches = append(batches, batch)
+			if len(batches) == len(signPIDs) {
+				break preprocessing
+			}
+		}
+	}
+
+	// every party must hold the same public commitments, and each party's own nonces must match one row of them
+	owners := make(map[int]bool, len(signPIDs))
+	for _, batch := range batches {
+		assert.NoError(t, batch.Validate())
+		assert.Equal(t, testBatchSize, batch.Len())
+		assert.Equal(t, batches[0].HidingCommitments, batch.HidingCommitments)
+		assert.Equal(t, batches[0].BindingCommitments, batch.BindingCommitments)
+		for j := range signPIDs {
+			match := true
+			for l := 0; l < batch.Len(); l++ {
+				match = match &&
+					crypto.ScalarBaseMult(tss.Edwards(), batch.HidingNonces[l]).Equals(batch.HidingCommitments[j][l]) &&
+					crypto.ScalarBaseMult(tss.Edwards(), batch.BindingNonces[l]).Equals(batch.BindingCommitments[j][l])
+			}
+			if match {
+				owners[j] = true
+			}
+		}
+	}
+	assert.Equal(t, len(signPIDs), len(owners), "each party's nonces must match its own commitments")
+}
@@ -0,0 +1,75 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package preprocessing
+
+import (
+	"crypto/elliptic"
+	"errors"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into eddsa-frost-preprocessing.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that preprocessing messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*PreprocessRound1Message)(nil),
+	}
+)
+
+// ----- //
+
+func NewPreprocessRound1Message(
+	from *tss.PartyID,
+	bigDs, bigEs []*crypto.ECPoint,
+) (tss.ParsedMessage, error) {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	flatDs, err := crypto.FlattenECPoints(bigDs)
+	if err != nil {
+		return nil, err
+	}
+	flatEs, err := crypto.FlattenECPoints(bigEs)
+	if err != nil {
+		return nil, err
+	}
+	content := &PreprocessRound1Message{
+		HidingCommitments:  common.BigIntsToBytes(flatDs),
+		BindingCommitments: common.BigIntsToBytes(flatEs),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg), nil
+}
+
+func (m *PreprocessRound1Message) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyMultiBytes(m.GetHidingCommitments()) &&
+		common.NonEmptyMultiBytes(m.GetBindingCommitments(), len(m.GetHidingCommitments())) &&
+		len(m.GetHidingCommitments())%2 == 0
+}
+
+// UnmarshalCommitments returns the hiding (D) and binding (E) nonce commitments, checking that they are on the curve
+func (m *PreprocessRound1Message) UnmarshalCommitments(ec elliptic.Curve) ([]*crypto.ECPoint, []*crypto.ECPoint, error) {
+	bigDs, err := crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetHidingCommitments()))
+	if err != nil {
+		return nil, nil, err
+	}
+	bigEs, err := crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetBindingCommitments()))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(bigDs) != len(bigEs) {
+		return nil, nil, errors.New("mismatched number of hiding and binding commitments")
+	}
+	return bigDs, bigEs, nil
+}
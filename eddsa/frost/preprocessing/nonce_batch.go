@@ -0,0 +1,124 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package preprocessing
+
+import (
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/agl/ed25519/edwards25519"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+)
+
+// ContextString is the FROST(Ed25519, SHA-512) context string of RFC 9591, section 6.1
+const ContextString = "FROST-ED25519-SHA512-v1"
+
+// NonceBatch is the output of the FROST preprocessing protocol. It holds a batch of nonce pairs for this party
+// and the matching nonce commitments of every signer, and is consumed one index at a time by `frost/signing`.
+//
+// ⚠️ Each index MUST be used to sign at most one message. The signing party wipes the secret nonces of the index
+// it used; persist the batch again after every signature so that a used index can never be loaded back.
+type NonceBatch struct {
+	// secret; this party's hiding (d) and binding (e) nonces
+	HidingNonces,
+	BindingNonces []*big.Int
+
+	// public; the hiding (D = d*G) and binding (E = e*G) commitments of every signer, indexed [party][nonce]
+	HidingCommitments,
+	BindingCommitments [][]*crypto.ECPoint
+
+	// the signer set (in sorted order) which produced this batch
+	Ks []*big.Int
+}
+
+// Len returns the number of nonce pairs in the batch.
+func (batch *NonceBatch) Len() int {
+	return len(batch.HidingNonces)
+}
+
+// IsUsed returns true when the nonce pair at `idx` has already been consumed.
+func (batch *NonceBatch) IsUsed(idx int) bool {
+	return batch.HidingNonces[idx] == nil || batch.BindingNonces[idx] == nil
+}
+
+// Validate performs a basic structural check of the batch.
+func (batch *NonceBatch) Validate() error {
+	if batch == nil {
+		return errors.New("nonce batch is nil")
+	}
+	if batch.Len() == 0 || len(batch.BindingNonces) != batch.Len() {
+		return errors.New("nonce batch has no nonces or mismatched nonce lengths")
+	}
+	if len(batch.Ks) == 0 || len(batch.HidingCommitments) != len(batch.Ks) || len(batch.BindingCommitments) != len(batch.Ks) {
+		return errors.New("nonce batch has inconsistent signer data lengths")
+	}
+	for j := range batch.Ks {
+		if len(batch.HidingCommitments[j]) != batch.Len() || len(batch.BindingCommitments[j]) != batch.Len() {
+			return fmt.Errorf("nonce batch has a wrong number of commitments for signer %d", j)
+		}
+		for l := range batch.HidingCommitments[j] {
+			if !batch.HidingCommitments[j][l].ValidateBasic() || !batch.BindingCommitments[j][l].ValidateBasic() {
+				return fmt.Errorf("nonce batch has an invalid commitment for signer %d", j)
+			}
+		}
+	}
+	return nil
+}
+
+// ----- //
+
+// nonceGenerate implements nonce_generate() of RFC 9591, section 4.1: H3(random_bytes(32) || SerializeScalar(secret))
+func nonceGenerate(rand io.Reader, secret *big.Int) (*big.Int, error) {
+	randomBytes, err := common.GetRandomBytesWithRand(rand, 32)
+	if err != nil {
+		return nil, err
+	}
+	secretEnc := scalarToEncodedBytes(secret)
+	h := sha512.New()
+	h.Write([]byte(ContextString + "nonce"))
+	h.Write(randomBytes)
+	h.Write(secretEnc[:])
+	var digest [64]byte
+	h.Sum(digest[:0])
+	var reduced [32]byte
+	edwards25519.ScReduce(&reduced, &digest)
+	return encodedBytesToScalar(&reduced), nil
+}
+
+// scalarToEncodedBytes serializes a scalar as 32 little-endian bytes
+func scalarToEncodedBytes(a *big.Int) *[32]byte {
+	s := new([32]byte)
+	bz := a.Bytes()
+	for i := 0; i < len(bz) && i < 32; i++ {
+		s[i] = bz[len(bz)-1-i]
+	}
+	return s
+}
+
+func encodedBytesToScalar(s *[32]byte) *big.Int {
+	bz := make([]byte, 32)
+	for i := range s {
+		bz[31-i] = s[i]
+	}
+	return new(big.Int).SetBytes(bz)
+}
+
+// isValidCommitment checks that a nonce commitment is in the prime-order subgroup and is not the identity
+func isValidCommitment(p *crypto.ECPoint) bool {
+	if !p.ValidateBasic() {
+		return false
+	}
+	if p.X().Sign() == 0 && p.Y().Cmp(big.NewInt(1)) == 0 {
+		return false
+	}
+	return p.EightInvEight().Equals(p)
+}
@@ -0,0 +1,90 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package preprocessing
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// round 1 represents the commit round of FROST (RFC 9591, section 5.1), run once for a whole batch of nonces
+func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *NonceBatch, temp *localTempData, out *tss.Outbox) tss.Round {
+	return &round1{
+		&base{params, key, data, temp, out, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	if round.temp.batchSize <= 0 {
+		return round.WrapError(errors.New("nonce batch size must be positive"))
+	}
+
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	n := round.temp.batchSize
+	ds, es := make([]*big.Int, n), make([]*big.Int, n)
+	bigDs, bigEs := make([]*crypto.ECPoint, n), make([]*crypto.ECPoint, n)
+	for l := 0; l < n; l++ {
+		var err error
+		if ds[l], err = nonceGenerate(round.Rand(), round.key.Xi); err != nil {
+			return round.WrapError(err)
+		}
+		if es[l], err = nonceGenerate(round.Rand(), round.key.Xi); err != nil {
+			return round.WrapError(err)
+		}
+		bigDs[l] = crypto.ScalarBaseMult(ec, ds[l])
+		bigEs[l] = crypto.ScalarBaseMult(ec, es[l])
+	}
+	round.temp.ds = ds
+	round.temp.es = es
+
+	i := round.PartyID().Index
+	round.ok[i] = true
+
+	r1msg, err := NewPreprocessRound1Message(round.PartyID(), bigDs, bigEs)
+	if err != nil {
+		return round.WrapError(err)
+	}
+	round.temp.preprocessRound1Messages[i] = r1msg
+	round.out.Send(r1msg)
+
+	return nil
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.preprocessRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*PreprocessRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &finalization{round}
+}
@@ -0,0 +1,96 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func (round *finalization) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	modN := common.ModInt(ec.Params().N)
+	l := round.temp.nonceIndex
+	z := big.NewInt(0)
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
+	for j, Pj := range round.Parties().IDs() {
+		round.ok[j] = true
+		r1msg := round.temp.signRound1Messages[j].Content().(*FrostSignRound1Message)
+		zj := r1msg.UnmarshalZ()
+		if !round.verifySignatureShare(j, zj) {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		z = modN.Add(z, zj)
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(fmt.Errorf("signature share verification failed for nonce index %d", l), culprits...)
+	}
+
+	// save the signature for final output
+	encR := ecPointToEncodedBytes(round.temp.bigR)
+	round.data.Signature = append(encR[:], bigIntToEncodedBytes(z)[:]...)
+	round.data.R = encodedBytesToBigInt(encR).Bytes()
+	round.data.S = z.Bytes()
+	round.data.M = round.temp.m.Bytes()
+
+	pk := edwards.PublicKey{
+		Curve: ec,
+		X:     round.key.EDDSAPub.X(),
+		Y:     round.key.EDDSAPub.Y(),
+	}
+	ok := edwards.Verify(&pk, round.temp.m.Bytes(), encodedBytesToBigInt(encR), z)
+	if !ok {
+		return round.WrapError(fmt.Errorf("signature verification failed"))
+	}
+	round.out.Finish(round.data)
+
+	return nil
+}
+
+func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *finalization) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *finalization) NextRound() tss.Round {
+	return nil // finished!
+}
+
+// verifySignatureShare checks z_j * G == D_j + rho_j * E_j + c * lambda_j * X_j
+func (round *finalization) verifySignatureShare(j int, zj *big.Int) bool {
+	ec := round.Params().EC()
+	modN := common.ModInt(ec.Params().N)
+	batch, l := round.temp.batch, round.temp.nonceIndex
+	expected, err := batch.HidingCommitments[j][l].Add(batch.BindingCommitments[j][l].ScalarMult(round.temp.rhos[j]))
+	if err != nil {
+		return false
+	}
+	if expected, err = expected.Add(round.key.BigXj[j].ScalarMult(modN.Mul(round.temp.c, round.temp.lambdas[j]))); err != nil {
+		return false
+	}
+	return crypto.ScalarBaseMult(ec, new(big.Int).Mod(zj, ec.Params().N)).Equals(expected)
+}
@@ -0,0 +1,148 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/ed25519"
+	"math/big"
+	"testing"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/frost/preprocessing"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/test"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+	testBatchSize    = 2
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+
+	// only for test
+	tss.SetCurve(tss.Edwards())
+}
+
+// runParties routes broadcast messages between the given parties until `done` is closed
+func runParties(t *testing.T, parties []tss.Party, outCh chan tss.Message, errCh chan *tss.Error, done <-chan struct{}) {
+	for _, P := range parties {
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	for {
+		select {
+		case <-done:
+			return
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			for _, P := range parties {
+				if P.PartyID().Index == msg.GetFrom().Index {
+					continue
+				}
+				go test.SharedPartyUpdater(P, msg, errCh)
+			}
+		}
+	}
+}
+
+func preprocess(t *testing.T, keys []keygen.LocalPartySaveData, signPIDs tss.SortedPartyIDs) []*preprocessing.NonceBatch {
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *preprocessing.NonceBatch, len(signPIDs))
+
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		parties = append(parties, preprocessing.NewLocalParty(params, keys[i], testBatchSize, outCh, endCh))
+	}
+	batches := make([]*preprocessing.NonceBatch, len(signPIDs))
+	done := make(chan struct{})
+	go func() {
+		for range signPIDs {
+			batch := <-endCh
+			// the parties finish out of order, match each result to its party by d_0 * G
+			for i := range parties {
+				if batch.HidingCommitments[i][0].Equals(crypto.ScalarBaseMult(tss.Edwards(), batch.HidingNonces[0])) {
+					batches[i] = batch
+				}
+			}
+		}
+		close(done)
+	}()
+	runParties(t, parties, outCh, errCh, done)
+	return batches
+}
+
+func sign(t *testing.T, msg *big.Int, keys []keygen.LocalPartySaveData, signPIDs tss.SortedPartyIDs, batches []*preprocessing.NonceBatch, nonceIndex int) []*common.SignatureData {
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		parties = append(parties, NewLocalParty(msg, params, keys[i], batches[i], nonceIndex, outCh, endCh))
+	}
+	sigs := make([]*common.SignatureData, 0, len(signPIDs))
+	done := make(chan struct{})
+	go func() {
+		for range signPIDs {
+			sigs = append(sigs, <-endCh)
+		}
+		close(done)
+	}()
+	runParties(t, parties, outCh, errCh, done)
+	return sigs
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// PHASE: preprocessing (offline)
+	batches := preprocess(t, keys, signPIDs)
+
+	// PHASE: signing, one message per nonce index
+	pk := ecPointToEncodedBytes(keys[0].EDDSAPub)
+	for l := 0; l < testBatchSize; l++ {
+		msg := big.NewInt(int64(42 + l))
+		sigs := sign(t, msg, keys, signPIDs, batches, l)
+		for _, sig := range sigs {
+			assert.Equal(t, sigs[0].Signature, sig.Signature, "all parties must output the same signature")
+		}
+		ok := ed25519.Verify(pk[:], msg.Bytes(), sigs[0].Signature)
+		assert.True(t, ok, "ed25519 verify must pass")
+	}
+
+	// a used nonce index must be rejected
+	for _, batch := range batches {
+		assert.True(t, batch.IsUsed(0), "nonces must be consumed after signing")
+	}
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[0], len(signPIDs), testThreshold)
+	P := NewLocalParty(big.NewInt(44), params, keys[0], batches[0], 0, make(chan tss.Message, 1), make(chan *common.SignatureData, 1))
+	assert.NotNil(t, P.Start(), "signing with a used nonce index must fail")
+}
@@ -0,0 +1,140 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	errors2 "github.com/pkg/errors"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/signing"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// round 1 represents the signing round of FROST (RFC 9591, section 5.2): each party broadcasts its share
+// z_i = d_i + e_i * rho_i + lambda_i * x_i * c
+func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *common.SignatureData, temp *localTempData, out *tss.Outbox) tss.Round {
+	return &round1{
+		&base{params, key, data, temp, out, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+
+	batch, l := round.temp.batch, round.temp.nonceIndex
+	if round.temp.m == nil {
+		return round.WrapError(errors.New("message is not valid"))
+	}
+	if err := batch.Validate(); err != nil {
+		return round.WrapError(err)
+	}
+	if l < 0 || batch.Len() <= l {
+		return round.WrapError(fmt.Errorf("nonce index %d is out of range for a batch of %d", l, batch.Len()))
+	}
+	if batch.IsUsed(l) {
+		return round.WrapError(fmt.Errorf("nonce index %d has already been used", l))
+	}
+	// the nonce batch may only be used by the exact signer set that produced it
+	Ks := round.key.Ks
+	if len(Ks) != len(batch.Ks) {
+		return round.WrapError(fmt.Errorf("nonce batch was made by %d signers but %d are present", len(batch.Ks), len(Ks)))
+	}
+	for j, Kj := range Ks {
+		if Kj.Cmp(batch.Ks[j]) != 0 {
+			return round.WrapError(errors.New("nonce batch was made by a different set of signers"), round.Parties().IDs()[j])
+		}
+	}
+
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	modN := common.ModInt(ec.Params().N)
+	i := round.PartyID().Index
+
+	bigDs, bigEs := make([]*crypto.ECPoint, len(Ks)), make([]*crypto.ECPoint, len(Ks))
+	for j := range Ks {
+		bigDs[j], bigEs[j] = batch.HidingCommitments[j][l], batch.BindingCommitments[j][l]
+	}
+	rhos := computeBindingFactors(round.key.EDDSAPub, round.temp.m.Bytes(), Ks, bigDs, bigEs)
+
+	// R = sum(D_j + rho_j * E_j)
+	var bigR *crypto.ECPoint
+	for j := range Ks {
+		bigRj, err := bigDs[j].Add(bigEs[j].ScalarMult(rhos[j]))
+		if err != nil {
+			return round.WrapError(errors2.Wrapf(err, "D_j + rho_j * E_j"), round.Parties().IDs()[j])
+		}
+		if bigR == nil {
+			bigR = bigRj
+		} else if bigR, err = bigR.Add(bigRj); err != nil {
+			return round.WrapError(errors2.Wrapf(err, "bigR.Add(bigRj)"))
+		}
+	}
+	if bigR.X().Sign() == 0 && bigR.Y().Cmp(big.NewInt(1)) == 0 {
+		return round.WrapError(errors.New("group commitment is the identity"))
+	}
+	c := computeChallenge(bigR, round.key.EDDSAPub, round.temp.m.Bytes())
+
+	lambdas := make([]*big.Int, len(Ks))
+	for j := range Ks {
+		lambdas[j] = signing.PrepareForSigning(ec, j, len(Ks), big.NewInt(1), Ks)
+	}
+	zi := modN.Add(
+		modN.Add(batch.HidingNonces[l], modN.Mul(batch.BindingNonces[l], rhos[i])),
+		modN.Mul(modN.Mul(lambdas[i], round.key.Xi), c))
+
+	// consume the nonce pair so that it can never be used to sign another message
+	batch.HidingNonces[l] = nil
+	batch.BindingNonces[l] = nil
+
+	round.temp.rhos = rhos
+	round.temp.lambdas = lambdas
+	round.temp.bigR = bigR
+	round.temp.c = c
+
+	round.ok[i] = true
+
+	r1msg := NewFrostSignRound1Message(round.PartyID(), zi)
+	round.temp.signRound1Messages[i] = r1msg
+	round.out.Send(r1msg)
+
+	return nil
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.signRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*FrostSignRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &finalization{round}
+}
@@ -0,0 +1,101 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/sha512"
+	"math/big"
+
+	"github.com/agl/ed25519/edwards25519"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/frost/preprocessing"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// computeBindingFactors implements compute_binding_factors() of RFC 9591, section 4.4, with the commitment list
+// taken in signer order and each identifier being the party key reduced mod L
+func computeBindingFactors(pk *crypto.ECPoint, msg []byte, ks []*big.Int, bigDs, bigEs []*crypto.ECPoint) []*big.Int {
+	comList := make([]byte, 0, len(ks)*96)
+	for j := range ks {
+		comList = append(comList, scalarToEncodedBytes(ks[j])[:]...)
+		comList = append(comList, ecPointToEncodedBytes(bigDs[j])[:]...)
+		comList = append(comList, ecPointToEncodedBytes(bigEs[j])[:]...)
+	}
+	prefix := ecPointToEncodedBytes(pk)[:]
+	prefix = append(prefix, hashWithContext("msg", msg)...)
+	prefix = append(prefix, hashWithContext("com", comList)...)
+
+	rhos := make([]*big.Int, len(ks))
+	for j := range ks {
+		input := append(append([]byte{}, prefix...), scalarToEncodedBytes(ks[j])[:]...)
+		rhos[j] = reduceDigest(hashWithContext("rho", input))
+	}
+	return rhos
+}
+
+// computeChallenge is the Ed25519 challenge H(R || PK || m) of RFC 8032, reduced mod L
+func computeChallenge(bigR, pk *crypto.ECPoint, msg []byte) *big.Int {
+	h := sha512.New()
+	h.Write(ecPointToEncodedBytes(bigR)[:])
+	h.Write(ecPointToEncodedBytes(pk)[:])
+	h.Write(msg)
+	return reduceDigest(h.Sum(nil))
+}
+
+func hashWithContext(tag string, msg []byte) []byte {
+	h := sha512.New()
+	h.Write([]byte(preprocessing.ContextString + tag))
+	h.Write(msg)
+	return h.Sum(nil)
+}
+
+func reduceDigest(digest []byte) *big.Int {
+	var wide [64]byte
+	copy(wide[:], digest)
+	var reduced [32]byte
+	edwards25519.ScReduce(&reduced, &wide)
+	return encodedBytesToBigInt(&reduced)
+}
+
+// ----- //
+
+// scalarToEncodedBytes serializes a scalar mod L as 32 little-endian bytes
+func scalarToEncodedBytes(a *big.Int) *[32]byte {
+	return bigIntToEncodedBytes(new(big.Int).Mod(a, tss.Edwards().Params().N))
+}
+
+func encodedBytesToBigInt(s *[32]byte) *big.Int {
+	bz := make([]byte, 32)
+	for i := range s {
+		bz[31-i] = s[i]
+	}
+	return new(big.Int).SetBytes(bz)
+}
+
+// ecPointToEncodedBytes is the RFC 8032 point encoding: y in little-endian with the sign of x in the top bit
+func ecPointToEncodedBytes(p *crypto.ECPoint) *[32]byte {
+	s := bigIntToEncodedBytes(p.Y())
+	xB := bigIntToEncodedBytes(p.X())
+	xFE := new(edwards25519.FieldElement)
+	edwards25519.FeFromBytes(xFE, xB)
+	if edwards25519.FeIsNegative(xFE) == 1 {
+		s[31] |= 1 << 7
+	} else {
+		s[31] &^= 1 << 7
+	}
+	return s
+}
+
+func bigIntToEncodedBytes(a *big.Int) *[32]byte {
+	s := new([32]byte)
+	bz := a.Bytes()
+	for i := 0; i < len(bz) && i < 32; i++ {
+		s[i] = bz[len(bz)-1-i]
+	}
+	return s
+}
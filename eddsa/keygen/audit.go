@@ -0,0 +1,75 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// AuditTranscript verifies the de-commitments and Schnorr proofs broadcast in round 2 of the transcript of a keygen
+// party. No secret is needed. The returned error names every party that sent an invalid proof, or is nil when the
+// proofs in the transcript are all valid; those of a session that failed before round 2 are missing.
+func AuditTranscript(t *tss.Transcript) *tss.Error {
+	params, err := t.Parameters()
+	if err != nil {
+		return tss.NewError(err, TaskName, -1, nil)
+	}
+	msgs, err := t.Messages()
+	if err != nil {
+		return tss.NewError(err, TaskName, -1, nil)
+	}
+	Ps := params.Parties().IDs()
+	r1msgs := make([]*KGRound1Message, len(Ps))
+	r2msg2s := make([]*KGRound2Message2, len(Ps))
+	for _, m := range msgs {
+		switch content := m.Msg.Content().(type) {
+		case *KGRound1Message:
+			r1msgs[m.Msg.GetFrom().Index] = content
+		case *KGRound2Message2:
+			r2msg2s[m.Msg.GetFrom().Index] = content
+		}
+	}
+
+	round := &base{Parameters: params, temp: &localTempData{ssidNonce: params.SSIDNonce()}, number: 1}
+	ssid, err := round.getSSID()
+	if err != nil {
+		return round.WrapError(err)
+	}
+	audit := tss.NewTranscriptAudit(TaskName, params.PartyID())
+	for j, r2msg2 := range r2msg2s {
+		if r2msg2 == nil || r1msgs[j] == nil {
+			continue
+		}
+		cmtDeCmt := commitments.HashCommitDecommit{C: r1msgs[j].UnmarshalCommitment(), D: r2msg2.UnmarshalDeCommitment()}
+		ok, flatPolyGs := cmtDeCmt.DeCommit()
+		if !ok || flatPolyGs == nil {
+			audit.Fail(2, Ps[j], errors.New("de-commitment verify failed"))
+			continue
+		}
+		PjVs, err := crypto.UnFlattenECPoints(params.EC(), flatPolyGs)
+		if err != nil {
+			audit.Fail(2, Ps[j], err)
+			continue
+		}
+		// the zero sharings of an import have no constant term and no proof
+		if len(PjVs) != params.Threshold()+1 {
+			continue
+		}
+		ContextJ := common.AppendBigIntToBytesSlice(ssid, big.NewInt(int64(j)))
+		proof, err := r2msg2.UnmarshalZKProof(params.EC())
+		if err != nil || !proof.Verify(ContextJ, PjVs[0].EightInvEight()) {
+			audit.Fail(2, Ps[j], errors.New("failed to prove schnorr proof"))
+		}
+	}
+	return audit.Err()
+}
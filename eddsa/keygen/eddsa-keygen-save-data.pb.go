@@ -0,0 +1,412 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.20.3
+// source: protob/eddsa-keygen-save-data.proto
+
+package keygen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The versioned encoding of a party's EdDSA key data, along with the parameters of the key.
+type SaveDataFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version    uint32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Curve      string                  `protobuf:"bytes,2,opt,name=curve,proto3" json:"curve,omitempty"`
+	Threshold  uint32                  `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Epoch      uint64                  `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Parties    []*SaveDataFile_PartyID `protobuf:"bytes,5,rep,name=parties,proto3" json:"parties,omitempty"`
+	PartyIndex uint32                  `protobuf:"varint,6,opt,name=party_index,json=partyIndex,proto3" json:"party_index,omitempty"`
+	// local secrets
+	Xi      []byte `protobuf:"bytes,7,opt,name=xi,proto3" json:"xi,omitempty"`
+	ShareId []byte `protobuf:"bytes,8,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	// public data of every party, in party order
+	Ks       [][]byte                `protobuf:"bytes,9,rep,name=ks,proto3" json:"ks,omitempty"`
+	BigXj    []*SaveDataFile_ECPoint `protobuf:"bytes,10,rep,name=big_xj,json=bigXj,proto3" json:"big_xj,omitempty"`
+	EddsaPub *SaveDataFile_ECPoint   `protobuf:"bytes,11,opt,name=eddsa_pub,json=eddsaPub,proto3" json:"eddsa_pub,omitempty"`
+}
+
+func (x *SaveDataFile) Reset() {
+	*x = SaveDataFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_eddsa_keygen_save_data_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveDataFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDataFile) ProtoMessage() {}
+
+func (x *SaveDataFile) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_eddsa_keygen_save_data_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDataFile.ProtoReflect.Descriptor instead.
+func (*SaveDataFile) Descriptor() ([]byte, []int) {
+	return file_protob_eddsa_keygen_save_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SaveDataFile) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SaveDataFile) GetCurve() string {
+	if x != nil {
+		return x.Curve
+	}
+	return ""
+}
+
+func (x *SaveDataFile) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *SaveDataFile) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *SaveDataFile) GetParties() []*SaveDataFile_PartyID {
+	if x != nil {
+		return x.Parties
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetPartyIndex() uint32 {
+	if x != nil {
+		return x.PartyIndex
+	}
+	return 0
+}
+
+func (x *SaveDataFile) GetXi() []byte {
+	if x != nil {
+		return x.Xi
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetShareId() []byte {
+	if x != nil {
+		return x.ShareId
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetKs() [][]byte {
+	if x != nil {
+		return x.Ks
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetBigXj() []*SaveDataFile_ECPoint {
+	if x != nil {
+		return x.BigXj
+	}
+	return nil
+}
+
+func (x *SaveDataFile) GetEddsaPub() *SaveDataFile_ECPoint {
+	if x != nil {
+		return x.EddsaPub
+	}
+	return nil
+}
+
+type SaveDataFile_PartyID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Moniker string `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Key     []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *SaveDataFile_PartyID) Reset() {
+	*x = SaveDataFile_PartyID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_eddsa_keygen_save_data_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveDataFile_PartyID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDataFile_PartyID) ProtoMessage() {}
+
+func (x *SaveDataFile_PartyID) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_eddsa_keygen_save_data_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDataFile_PartyID.ProtoReflect.Descriptor instead.
+func (*SaveDataFile_PartyID) Descriptor() ([]byte, []int) {
+	return file_protob_eddsa_keygen_save_data_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *SaveDataFile_PartyID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SaveDataFile_PartyID) GetMoniker() string {
+	if x != nil {
+		return x.Moniker
+	}
+	return ""
+}
+
+func (x *SaveDataFile_PartyID) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type SaveDataFile_ECPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X []byte `protobuf:"bytes,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y []byte `protobuf:"bytes,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *SaveDataFile_ECPoint) Reset() {
+	*x = SaveDataFile_ECPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_eddsa_keygen_save_data_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveDataFile_ECPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDataFile_ECPoint) ProtoMessage() {}
+
+func (x *SaveDataFile_ECPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_eddsa_keygen_save_data_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDataFile_ECPoint.ProtoReflect.Descriptor instead.
+func (*SaveDataFile_ECPoint) Descriptor() ([]byte, []int) {
+	return file_protob_eddsa_keygen_save_data_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *SaveDataFile_ECPoint) GetX() []byte {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *SaveDataFile_ECPoint) GetY() []byte {
+	if x != nil {
+		return x.Y
+	}
+	return nil
+}
+
+var File_protob_eddsa_keygen_save_data_proto protoreflect.FileDescriptor
+
+var file_protob_eddsa_keygen_save_data_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2f, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2d, 0x6b,
+	0x65, 0x79, 0x67, 0x65, 0x6e, 0x2d, 0x73, 0x61, 0x76, 0x65, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74,
+	0x73, 0x73, 0x6c, 0x69, 0x62, 0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x6b, 0x65, 0x79, 0x67,
+	0x65, 0x6e, 0x22, 0xa3, 0x04, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x75,
+	0x72, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x4b, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x74, 0x73, 0x73, 0x6c, 0x69, 0x62, 0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e,
+	0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x46,
+	0x69, 0x6c, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x44, 0x52, 0x07, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x02, 0x78, 0x69, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x02, 0x6b, 0x73,
+	0x12, 0x48, 0x0a, 0x06, 0x62, 0x69, 0x67, 0x5f, 0x78, 0x6a, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73, 0x73, 0x6c, 0x69,
+	0x62, 0x2e, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2e, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x2e, 0x53,
+	0x61, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x43, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x05, 0x62, 0x69, 0x67, 0x58, 0x6a, 0x12, 0x4e, 0x0a, 0x09, 0x65, 0x64,
+	0x64, 0x73, 0x61, 0x5f, 0x70, 0x75, 0x62, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73, 0x73, 0x6c, 0x69, 0x62, 0x2e, 0x65,
+	0x64, 0x64, 0x73, 0x61, 0x2e, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x2e, 0x53, 0x61, 0x76, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x45, 0x43, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x08, 0x65, 0x64, 0x64, 0x73, 0x61, 0x50, 0x75, 0x62, 0x1a, 0x45, 0x0a, 0x07, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x1a, 0x25, 0x0a, 0x07, 0x45, 0x43, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x79, 0x42, 0x0e, 0x5a, 0x0c, 0x65, 0x64, 0x64, 0x73,
+	0x61, 0x2f, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protob_eddsa_keygen_save_data_proto_rawDescOnce sync.Once
+	file_protob_eddsa_keygen_save_data_proto_rawDescData = file_protob_eddsa_keygen_save_data_proto_rawDesc
+)
+
+func file_protob_eddsa_keygen_save_data_proto_rawDescGZIP() []byte {
+	file_protob_eddsa_keygen_save_data_proto_rawDescOnce.Do(func() {
+		file_protob_eddsa_keygen_save_data_proto_rawDescData = protoimpl.X.CompressGZIP(file_protob_eddsa_keygen_save_data_proto_rawDescData)
+	})
+	return file_protob_eddsa_keygen_save_data_proto_rawDescData
+}
+
+var file_protob_eddsa_keygen_save_data_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_protob_eddsa_keygen_save_data_proto_goTypes = []interface{}{
+	(*SaveDataFile)(nil),         // 0: binance.tsslib.eddsa.keygen.SaveDataFile
+	(*SaveDataFile_PartyID)(nil), // 1: binance.tsslib.eddsa.keygen.SaveDataFile.PartyID
+	(*SaveDataFile_ECPoint)(nil), // 2: binance.tsslib.eddsa.keygen.SaveDataFile.ECPoint
+}
+var file_protob_eddsa_keygen_save_data_proto_depIdxs = []int32{
+	1, // 0: binance.tsslib.eddsa.keygen.SaveDataFile.parties:type_name -> binance.tsslib.eddsa.keygen.SaveDataFile.PartyID
+	2, // 1: binance.tsslib.eddsa.keygen.SaveDataFile.big_xj:type_name -> binance.tsslib.eddsa.keygen.SaveDataFile.ECPoint
+	2, // 2: binance.tsslib.eddsa.keygen.SaveDataFile.eddsa_pub:type_name -> binance.tsslib.eddsa.keygen.SaveDataFile.ECPoint
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_protob_eddsa_keygen_save_data_proto_init() }
+func file_protob_eddsa_keygen_save_data_proto_init() {
+	if File_protob_eddsa_keygen_save_data_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protob_eddsa_keygen_save_data_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveDataFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_eddsa_keygen_save_data_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveDataFile_PartyID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_eddsa_keygen_save_data_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveDataFile_ECPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protob_eddsa_keygen_save_data_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protob_eddsa_keygen_save_data_proto_goTypes,
+		DependencyIndexes: file_protob_eddsa_keygen_save_data_proto_depIdxs,
+		MessageInfos:      file_protob_eddsa_keygen_save_data_proto_msgTypes,
+	}.Build()
+	File_protob_eddsa_keygen_save_data_proto = out.File
+	file_protob_eddsa_keygen_save_data_proto_rawDesc = nil
+	file_protob_eddsa_keygen_save_data_proto_goTypes = nil
+	file_protob_eddsa_keygen_save_data_proto_depIdxs = nil
+}
@@ -0,0 +1,126 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/vss"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// NewImportLocalParty returns a keygen party which turns an existing private key into a threshold key with the same
+// public key. The `importer` holds the key and passes it as `secret`; it becomes the constant of its polynomial.
+// Every other party passes a nil `secret` and contributes a sharing of zero.
+//
+// All parties must agree on who the `importer` is. The save data is the same as that of a normal keygen.
+func NewImportLocalParty(
+	params *tss.Parameters,
+	importer *tss.PartyID,
+	secret *big.Int,
+	out chan<- tss.Message,
+	end chan<- *LocalPartySaveData,
+) tss.Party {
+	p := NewLocalParty(params, out, end).(*LocalParty)
+	p.temp.importer = findParty(params.Parties().IDs(), importer)
+	if p.temp.importer == nil {
+		panic(errors.New("keygen.NewImportLocalParty: the importer is not one of the parties"))
+	}
+	if p.temp.importer.Index == params.PartyID().Index {
+		if secret == nil || secret.Sign() <= 0 || secret.Cmp(params.EC().Params().N) >= 0 {
+			panic(errors.New("keygen.NewImportLocalParty: the secret must be in [1, N)"))
+		}
+		p.temp.importSecret = new(big.Int).Set(secret)
+	} else if secret != nil {
+		panic(errors.New("keygen.NewImportLocalParty: only the importer may provide a secret"))
+	}
+	return p
+}
+
+// DealerImport splits `secret` into the key data of each of `ids` as a trusted dealer. The result is the same as that
+// of a normal keygen run by `ids`, and must be distributed to the parties over secure channels.
+func DealerImport(
+	ec elliptic.Curve,
+	secret *big.Int,
+	ids tss.SortedPartyIDs,
+	threshold int,
+) ([]LocalPartySaveData, error) {
+	if secret == nil || secret.Sign() <= 0 || secret.Cmp(ec.Params().N) >= 0 {
+		return nil, errors.New("the secret must be in [1, N)")
+	}
+	if threshold < 1 || len(ids) <= threshold {
+		return nil, fmt.Errorf("invalid threshold %d for %d parties", threshold, len(ids))
+	}
+
+	ks := ids.Keys()
+	vs, shares, err := vss.Create(ec, threshold, secret, ks)
+	if err != nil {
+		return nil, err
+	}
+	bigXj := make([]*crypto.ECPoint, len(ids))
+	for j, share := range shares {
+		bigXj[j] = crypto.ScalarBaseMult(ec, share.Share)
+	}
+
+	keys := make([]LocalPartySaveData, len(ids))
+	for i := range ids {
+		save := NewLocalPartySaveData(len(ids))
+		save.Xi, save.ShareID = shares[i].Share, ks[i]
+		copy(save.Ks, ks)
+		copy(save.BigXj, bigXj)
+		save.EDDSAPub = vs[0]
+		keys[i] = save
+	}
+	return keys, nil
+}
+
+// SecretFromEd25519Seed returns the secret scalar of a standard Ed25519 private key seed (RFC 8032, section 5.1.5),
+// for use with NewImportLocalParty or DealerImport. The threshold key has the same public key as the seed.
+func SecretFromEd25519Seed(seed []byte) (*big.Int, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("expected a seed of %d bytes, got %d", ed25519.SeedSize, len(seed))
+	}
+	h := sha512.Sum512(seed)
+	h[0] &= 248
+	h[31] &= 127
+	h[31] |= 64
+	// the scalar is encoded little-endian
+	s := make([]byte, 32)
+	for i := range s {
+		s[i] = h[31-i]
+	}
+	return new(big.Int).Mod(new(big.Int).SetBytes(s), tss.Edwards().Params().N), nil
+}
+
+// ----- //
+
+// vsOffset returns the index of the first commitment Pj broadcast in its Vs: 1 for the zero sharings of an import,
+// which have no constant term, and 0 otherwise
+func (round *base) vsOffset(j int) int {
+	if round.temp.importer != nil && round.temp.importer.Index != j {
+		return 1
+	}
+	return 0
+}
+
+func findParty(ids tss.SortedPartyIDs, p *tss.PartyID) *tss.PartyID {
+	if p == nil {
+		return nil
+	}
+	for _, id := range ids {
+		if id.KeyInt().Cmp(p.KeyInt()) == 0 {
+			return id
+		}
+	}
+	return nil
+}
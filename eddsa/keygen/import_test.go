@@ -0,0 +1,123 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"crypto/ed25519"
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/vss"
+	"github.com/bnb-chain/tss-lib/v2/test"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func assertImported(t *testing.T, secret *big.Int, keys []LocalPartySaveData) {
+	ec := tss.Edwards()
+	pub := crypto.ScalarBaseMult(ec, secret)
+	shares := make(vss.Shares, 0, len(keys))
+	for i, key := range keys {
+		assert.True(t, pub.Equals(key.EDDSAPub), "the public key must be that of the imported secret")
+		assert.True(t, crypto.ScalarBaseMult(ec, key.Xi).Equals(key.BigXj[i]))
+		for j := range keys {
+			assert.True(t, key.BigXj[j].Equals(keys[0].BigXj[j]), "all parties must agree on the public shares")
+		}
+		shares = append(shares, &vss.Share{Threshold: testThreshold, ID: key.ShareID, Share: key.Xi})
+	}
+	recovered, err := shares[len(shares)-testThreshold-1:].ReConstruct(ec)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, secret.Cmp(recovered), "the shares must reconstruct the imported secret")
+}
+
+func TestSecretFromEd25519Seed(t *testing.T) {
+	seed := make([]byte, ed25519.SeedSize)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	secret, err := SecretFromEd25519Seed(seed)
+	assert.NoError(t, err)
+
+	pub := crypto.ScalarBaseMult(tss.Edwards(), secret)
+	pk := edwards.PublicKey{Curve: tss.Edwards(), X: pub.X(), Y: pub.Y()}
+	expected := ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)
+	assert.Equal(t, []byte(expected), pk.Serialize(), "the public key must match that of the seed")
+
+	_, err = SecretFromEd25519Seed(seed[1:])
+	assert.Error(t, err)
+}
+
+func TestDealerImport(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	secret := common.GetRandomPositiveInt(tss.Edwards().Params().N)
+	keys, err := DealerImport(tss.Edwards(), secret, pIDs, testThreshold)
+	assert.NoError(t, err)
+	assertImported(t, secret, keys)
+
+	_, err = DealerImport(tss.Edwards(), big.NewInt(0), pIDs, testThreshold)
+	assert.Error(t, err, "a zero secret must be rejected")
+}
+
+func TestE2EConcurrentImport(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan *LocalPartySaveData, len(pIDs))
+
+	secret := common.GetRandomPositiveInt(tss.Edwards().Params().N)
+	importer := pIDs[1]
+	parties := make([]*LocalParty, 0, len(pIDs))
+	for i := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+		var s *big.Int
+		if i == importer.Index {
+			s = secret
+		}
+		P := NewImportLocalParty(params, importer, s, outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	keys := make([]LocalPartySaveData, len(pIDs))
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go test.SharedPartyUpdater(P, msg, errCh)
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case save := <-endCh:
+			index, err := save.OriginalIndex()
+			assert.NoErrorf(t, err, "should not be an error getting a party's index from save data")
+			keys[index] = *save
+			ended++
+		}
+	}
+	assertImported(t, secret, keys)
+}
@@ -7,7 +7,9 @@
 package keygen
 
 import (
+	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
@@ -21,6 +23,7 @@ import (
 
 	"github.com/bnb-chain/tss-lib/v2/common"
 	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/sealed"
 	"github.com/bnb-chain/tss-lib/v2/crypto/vss"
 	"github.com/bnb-chain/tss-lib/v2/test"
 	"github.com/bnb-chain/tss-lib/v2/tss"
@@ -236,3 +239,278 @@ func tryWriteTestFixtureFile(t *testing.T, index int, data LocalPartySaveData) {
 	}
 	//
 }
+
+func TestE2EConcurrentWithSecureChannel(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan *LocalPartySaveData, len(pIDs))
+
+	// every party has a static identity, known to the others
+	identities := make([]*tss.Identity, len(pIDs))
+	peers := make(map[string]*tss.PeerIdentity, len(pIDs))
+	for i, Pi := range pIDs {
+		identity, err := tss.NewIdentity(rand.Reader)
+		assert.NoError(t, err)
+		identities[i], peers[Pi.Id] = identity, identity.Public()
+	}
+	parties := make([]*LocalParty, 0, len(pIDs))
+	for i := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+		params.SetSessionID([]byte("keygen with a secure channel"))
+		assert.NoError(t, params.SetSecureChannel(identities[i], peers))
+		parties = append(parties, NewLocalParty(params, outCh, endCh).(*LocalParty))
+	}
+	for _, P := range parties {
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	spoofed := false
+	keys := make([]LocalPartySaveData, len(pIDs))
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go test.SharedPartyUpdater(P, msg, errCh)
+				}
+				continue
+			}
+			// the shares must not be readable on the wire
+			bz, _, err := msg.WireBytes()
+			assert.NoError(t, err)
+			wire, err := tss.ParseWireMessage(bz, msg.GetFrom(), false)
+			assert.NoError(t, err)
+			if env, ok := wire.Content().(*tss.SecureEnvelope); assert.True(t, ok) {
+				assert.NotEmpty(t, env.GetNonce(), "point-to-point messages must be encrypted")
+			}
+			// a relay cannot pass the message off as coming from another party
+			if !spoofed {
+				other := pIDs[(msg.GetFrom().Index+1)%len(pIDs)]
+				if other.Index == dest[0].Index {
+					other = pIDs[(other.Index+1)%len(pIDs)]
+				}
+				_, tssErr := parties[dest[0].Index].UpdateFromBytes(bz, other, false)
+				assert.NotNil(t, tssErr, "a msg with a spoofed sender must be rejected")
+				spoofed = true
+			}
+			go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+		case save := <-endCh:
+			index, err := save.OriginalIndex()
+			assert.NoErrorf(t, err, "should not be an error getting a party's index from save data")
+			keys[index] = *save
+			ended++
+		}
+	}
+	assert.True(t, spoofed)
+	for _, key := range keys {
+		assert.True(t, key.EDDSAPub.Equals(keys[0].EDDSAPub), "all parties must agree on the public key")
+	}
+}
+
+func TestE2EConcurrentWithCheckpoint(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan *LocalPartySaveData, len(pIDs))
+	kek, err := sealed.NewPassphraseKEK([]byte("passphrase"))
+	assert.NoError(t, err)
+
+	// the parties use a secure channel, whose sealed messages must not end up in the checkpoints
+	identities := make([]*tss.Identity, len(pIDs))
+	peers := make(map[string]*tss.PeerIdentity, len(pIDs))
+	for i, Pi := range pIDs {
+		identity, err := tss.NewIdentity(rand.Reader)
+		assert.NoError(t, err)
+		identities[i], peers[Pi.Id] = identity, identity.Public()
+	}
+	newParams := func(i int) *tss.Parameters {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+		params.SetSessionID([]byte("keygen with checkpoints"))
+		assert.NoError(t, params.SetSecureChannel(identities[i], peers))
+		return params
+	}
+
+	// party 0 crashes while it saves its checkpoint in round 2, so what it sent in the round is dropped
+	errCrash := errors.New("crashed")
+	var saved []byte
+	epochs := new(test.CheckpointEpochs)
+	parties := make([]*LocalParty, 0, len(pIDs))
+	for i := range pIDs {
+		P := NewLocalParty(newParams(i), outCh, endCh).(*LocalParty)
+		if i == 0 {
+			P.EnableCheckpoints(kek, epochs, func(checkpoint []byte) error {
+				saved = checkpoint
+				if md, _ := sealed.ReadMetadata(checkpoint); md.Epoch == 1 {
+					return errCrash
+				}
+				return nil
+			})
+		}
+		parties = append(parties, P)
+	}
+	for _, P := range parties {
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	// the transport keeps the messages for party 0, so that they can be delivered again after it restarts
+	var toParty0 []tss.Message
+	deliver := func(j int, msg tss.Message) {
+		if j == 0 {
+			toParty0 = append(toParty0, msg)
+		}
+		go test.SharedPartyUpdater(parties[j], msg, errCh)
+	}
+
+	resumed := false
+	keys := make([]LocalPartySaveData, len(pIDs))
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			if !errors.Is(err, errCrash) {
+				assert.FailNow(t, err.Error())
+			}
+			if resumed {
+				// the crashed party rejects the messages that were on their way to it
+				continue
+			}
+			resumed = true
+			P, err2 := ResumeLocalParty(saved, kek, epochs, newParams(0), outCh, endCh)
+			assert.NoError(t, err2)
+			parties[0] = P.(*LocalParty)
+			assert.Nil(t, parties[0].Start())
+			for _, msg := range toParty0 {
+				go test.SharedPartyUpdater(parties[0], msg, errCh)
+			}
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for j := range parties {
+					if j != msg.GetFrom().Index {
+						deliver(j, msg)
+					}
+				}
+			} else {
+				deliver(dest[0].Index, msg)
+			}
+		case save := <-endCh:
+			index, err := save.OriginalIndex()
+			assert.NoErrorf(t, err, "should not be an error getting a party's index from save data")
+			keys[index] = *save
+			ended++
+		}
+	}
+	assert.True(t, resumed, "party 0 should have been resumed")
+	for _, key := range keys {
+		assert.True(t, key.EDDSAPub.Equals(keys[0].EDDSAPub), "all parties must agree on the public key")
+	}
+}
+
+func TestE2EConcurrentWithTranscript(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan *LocalPartySaveData, len(pIDs))
+
+	transcripts := make([]*tss.Transcript, len(pIDs))
+	parties := make([]*LocalParty, 0, len(pIDs))
+	for i := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+		transcripts[i] = tss.NewTranscript()
+		params.SetTranscript(transcripts[i])
+		parties = append(parties, NewLocalParty(params, outCh, endCh).(*LocalParty))
+	}
+	for _, P := range parties {
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				dest = pIDs
+			}
+			for _, Pj := range dest {
+				if Pj.Index != msg.GetFrom().Index {
+					go test.SharedPartyUpdater(parties[Pj.Index], msg, errCh)
+				}
+			}
+		case <-endCh:
+			ended++
+		}
+	}
+	// the parties record that they finished once the update that ended them returns
+	for _, P := range parties {
+		P.WaitingFor()
+	}
+
+	// the transcripts survive encoding, and their proofs are checked without any secret
+	for _, tr := range transcripts {
+		bz, err := tr.Marshal()
+		assert.NoError(t, err)
+		decoded, err := tss.UnmarshalTranscript(bz)
+		assert.NoError(t, err)
+		finished, errEvent := decoded.Outcome()
+		assert.True(t, finished)
+		assert.Nil(t, errEvent)
+		assert.Nil(t, AuditTranscript(decoded), "the proofs of an honest session must be valid")
+	}
+
+	// party 0 received the Schnorr proof of party 2 from party 1
+	var proof []byte
+	msgs, err := transcripts[0].Messages()
+	assert.NoError(t, err)
+	for _, m := range msgs {
+		if r2msg2, ok := m.Msg.Content().(*KGRound2Message2); ok && m.Msg.GetFrom().Index == 2 {
+			proof = r2msg2.ProofT
+		}
+	}
+	for i, e := range transcripts[0].Events {
+		if e.Kind != tss.TranscriptReceived {
+			continue
+		}
+		msg, err := tss.UnmarshalMessage(e.Message, pIDs)
+		assert.NoError(t, err)
+		if r2msg2, ok := msg.Content().(*KGRound2Message2); ok && msg.GetFrom().Index == 1 {
+			r2msg2.ProofT = proof
+			transcripts[0].Events[i].Message, err = tss.MarshalMessage(msg)
+			assert.NoError(t, err)
+		}
+	}
+	tssErr := AuditTranscript(transcripts[0])
+	if assert.NotNil(t, tssErr) {
+		assert.Equal(t, 2, tssErr.Round())
+		if assert.Len(t, tssErr.Culprits(), 1) {
+			assert.Equal(t, pIDs[1].Key, tssErr.Culprits()[0].Key)
+		}
+	}
+}
@@ -93,9 +93,11 @@ func NewKGRound2Message2(
 	dcBzs := common.BigIntsToBytes(deCommitment)
 	content := &KGRound2Message2{
 		DeCommitment: dcBzs,
-		ProofAlphaX:  proof.Alpha.X().Bytes(),
-		ProofAlphaY:  proof.Alpha.Y().Bytes(),
-		ProofT:       proof.T.Bytes(),
+	}
+	if proof != nil {
+		content.ProofAlphaX = proof.Alpha.X().Bytes()
+		content.ProofAlphaY = proof.Alpha.Y().Bytes()
+		content.ProofT = proof.T.Bytes()
 	}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg)
@@ -22,9 +22,9 @@ var (
 )
 
 // round 1 represents round 1 of the keygen part of the EDDSA TSS spec
-func newRound1(params *tss.Parameters, save *LocalPartySaveData, temp *localTempData, out chan<- tss.Message, end chan<- *LocalPartySaveData) tss.Round {
+func newRound1(params *tss.Parameters, save *LocalPartySaveData, temp *localTempData, out *tss.Outbox) tss.Round {
 	return &round1{
-		&base{params, save, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+		&base{params, save, temp, out, make([]bool, len(params.Parties().IDs())), false, 1}}
 }
 
 func (round *round1) Start() *tss.Error {
@@ -38,7 +38,7 @@ func (round *round1) Start() *tss.Error {
 	Pi := round.PartyID()
 	i := Pi.Index
 
-	round.temp.ssidNonce = new(big.Int).SetUint64(0)
+	round.temp.ssidNonce = round.SSIDNonce()
 	ssid, err := round.getSSID()
 	if err != nil {
 		return round.WrapError(err)
@@ -46,12 +46,23 @@ func (round *round1) Start() *tss.Error {
 	round.temp.ssid = ssid
 
 	// 1. calculate "partial" key share ui
-	ui := common.GetRandomPositiveInt(round.Params().EC().Params().N)
-	round.temp.ui = ui
-
-	// 2. compute the vss shares
+	// when importing a key, the importer's ui is the key and the other parties share zero
 	ids := round.Parties().IDs().Keys()
-	vs, shares, err := vss.Create(round.Params().EC(), round.Threshold(), ui, ids)
+	var ui *big.Int
+	var vs vss.Vs
+	var shares vss.Shares
+	if round.vsOffset(i) == 1 {
+		vs, shares, err = vss.CreateZeroSharing(round.Rand(), round.Params().EC(), round.Threshold(), ids)
+	} else {
+		if ui = round.temp.importSecret; ui == nil {
+			ui = common.GetRandomPositiveIntWithRand(round.Rand(), round.Params().EC().Params().N)
+		}
+		round.temp.ui = ui
+
+		// 2. compute the vss shares
+		vs, shares, err = vss.CreateWithRand(round.Rand(), round.Params().EC(), round.Threshold(), ui, ids)
+	}
+	round.temp.importSecret = nil
 	if err != nil {
 		return round.WrapError(err, Pi)
 	}
@@ -66,7 +77,7 @@ func (round *round1) Start() *tss.Error {
 	if err != nil {
 		return round.WrapError(err, Pi)
 	}
-	cmt := cmts.NewHashCommitment(pGFlat...)
+	cmt := cmts.NewHashCommitmentWithRand(round.Rand(), pGFlat...)
 
 	// for this P: SAVE
 	// - shareID
@@ -83,7 +94,7 @@ func (round *round1) Start() *tss.Error {
 	{
 		msg := NewKGRound1Message(round.PartyID(), cmt.C)
 		round.temp.kgRound1Messages[i] = msg
-		round.out <- msg
+		round.out.Send(msg)
 	}
 	return nil
 }
@@ -9,6 +9,7 @@ package keygen
 import (
 	"errors"
 	"math/big"
+	"time"
 
 	errors2 "github.com/pkg/errors"
 
@@ -42,20 +43,27 @@ func (round *round2) Start() *tss.Error {
 			continue
 		}
 		round.temp.kgRound2Message1s[i] = r2msg1
-		round.out <- r2msg1
+		round.out.Send(r2msg1)
 	}
 
 	// 5. compute Schnorr prove
-	ContextI := append(round.temp.ssid, new(big.Int).SetUint64(uint64(i)).Bytes()...)
-	pii, err := schnorr.NewZKProof(ContextI, round.temp.ui, round.temp.vs[0])
-	if err != nil {
-		return round.WrapError(errors2.Wrapf(err, "NewZKProof(ui, vi0)"))
+	// a sharing of zero for a key import has no constant term to prove
+	var pii *schnorr.ZKProof
+	if round.vsOffset(i) == 0 {
+		var err error
+		ContextI := append(round.temp.ssid, new(big.Int).SetUint64(uint64(i)).Bytes()...)
+		start := time.Now()
+		pii, err = schnorr.NewZKProofWithRand(round.Rand(), ContextI, round.temp.ui, round.temp.vs[0])
+		if err != nil {
+			return round.WrapError(errors2.Wrapf(err, "NewZKProof(ui, vi0)"))
+		}
+		round.ObserveProofGenerated(tss.ProofSchnorr, start)
 	}
 
 	// 5. BROADCAST de-commitments of Shamir poly*G and Schnorr prove
 	r2msg2 := NewKGRound2Message2(round.PartyID(), round.temp.deCommitPolyG, pii)
 	round.temp.kgRound2Message2s[i] = r2msg2
-	round.out <- r2msg2
+	round.out.Send(r2msg2)
 
 	return nil
 }
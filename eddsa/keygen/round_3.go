@@ -9,6 +9,7 @@ package keygen
 import (
 	"errors"
 	"math/big"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	errors2 "github.com/pkg/errors"
@@ -45,8 +46,8 @@ func (round *round3) Start() *tss.Error {
 
 	// 2-3.
 	Vc := make(vss.Vs, round.Threshold()+1)
-	for c := range Vc {
-		Vc[c] = round.temp.vs[c] // ours
+	for c, v := range round.temp.vs {
+		Vc[c+round.vsOffset(PIdx)] = v // ours
 	}
 
 	// 4-12.
@@ -89,15 +90,23 @@ func (round *round3) Start() *tss.Error {
 				ch <- vssOut{err, nil}
 				return
 			}
-			proof, err := r2msg2.UnmarshalZKProof(round.Params().EC())
-			if err != nil {
-				ch <- vssOut{errors.New("failed to unmarshal schnorr proof"), nil}
+			if len(PjVs) != round.Threshold()+1-round.vsOffset(j) {
+				ch <- vssOut{errors.New("unexpected number of vss commitments"), nil}
 				return
 			}
-			ok = proof.Verify(ContextJ, PjVs[0])
-			if !ok {
-				ch <- vssOut{errors.New("failed to prove schnorr proof"), nil}
-				return
+			if round.vsOffset(j) == 0 {
+				proof, err := r2msg2.UnmarshalZKProof(round.Params().EC())
+				if err != nil {
+					ch <- vssOut{errors.New("failed to unmarshal schnorr proof"), nil}
+					return
+				}
+				start := time.Now()
+				ok = proof.Verify(ContextJ, PjVs[0])
+				round.ObserveProofVerified(tss.ProofSchnorr, ok, start)
+				if !ok {
+					ch <- vssOut{errors.New("failed to prove schnorr proof"), nil}
+					return
+				}
 			}
 			r2msg1 := round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1)
 			PjShare := vss.Share{
@@ -105,7 +114,12 @@ func (round *round3) Start() *tss.Error {
 				ID:        round.PartyID().KeyInt(),
 				Share:     r2msg1.UnmarshalShare(),
 			}
-			if ok = PjShare.Verify(round.Params().EC(), round.Threshold(), PjVs); !ok {
+			if round.vsOffset(j) == 1 {
+				ok = PjShare.VerifyZeroShare(round.Params().EC(), round.Threshold(), PjVs)
+			} else {
+				ok = PjShare.Verify(round.Params().EC(), round.Threshold(), PjVs)
+			}
+			if !ok {
 				ch <- vssOut{errors.New("vss verify failed"), nil}
 				return
 			}
@@ -148,8 +162,13 @@ func (round *round3) Start() *tss.Error {
 			}
 			// 11-12.
 			PjVs := vssResults[j].pjVs
-			for c := 0; c <= round.Threshold(); c++ {
-				Vc[c], err = Vc[c].Add(PjVs[c])
+			for c, v := range PjVs {
+				c += round.vsOffset(j)
+				if Vc[c] == nil {
+					Vc[c] = v
+					continue
+				}
+				Vc[c], err = Vc[c].Add(v)
 				if err != nil {
 					culprits = append(culprits, Pj)
 				}
@@ -193,10 +212,10 @@ func (round *round3) Start() *tss.Error {
 	}
 	round.save.EDDSAPub = eddsaPubKey
 
-	// PRINT public key & private share
-	common.Logger.Debugf("%s public key: %x", round.PartyID(), eddsaPubKey)
-
-	round.end <- round.save
+	for j := range round.ok {
+		round.ok[j] = true
+	}
+	round.out.Finish(round.save)
 	return nil
 }
 
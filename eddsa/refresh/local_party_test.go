@@ -0,0 +1,143 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/signing"
+	"github.com/bnb-chain/tss-lib/v2/test"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+
+	// only for test
+	tss.SetCurve(tss.Edwards())
+}
+
+// runParties routes messages between the given parties until `done` is closed
+func runParties(t *testing.T, parties []tss.Party, outCh chan tss.Message, errCh chan *tss.Error, done <-chan struct{}) {
+	for _, P := range parties {
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	for {
+		select {
+		case <-done:
+			// the parties are done once the updates that ended them return
+			for _, P := range parties {
+				assert.Empty(t, P.WaitingFor(), "a finished party must not wait for anyone")
+			}
+			return
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go test.SharedPartyUpdater(P, msg, errCh)
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		}
+	}
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// PHASE: refresh
+	p2pCtx := tss.NewPeerContext(pIDs)
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan *keygen.LocalPartySaveData, len(pIDs))
+
+	parties := make([]tss.Party, 0, len(pIDs))
+	for i := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+		parties = append(parties, NewLocalParty(params, keys[i], outCh, endCh))
+	}
+	refreshed := make([]keygen.LocalPartySaveData, len(pIDs))
+	done := make(chan struct{})
+	go func() {
+		for range pIDs {
+			save := <-endCh
+			index, err := save.OriginalIndex()
+			assert.NoErrorf(t, err, "should not be an error getting a party's index from save data")
+			refreshed[index] = *save
+		}
+		close(done)
+	}()
+	runParties(t, parties, outCh, errCh, done)
+
+	for i, save := range refreshed {
+		assert.True(t, keys[i].EDDSAPub.Equals(save.EDDSAPub), "the public key must not change")
+		assert.Equal(t, keys[i].Ks, save.Ks, "the party keys must not change")
+		assert.NotEqual(t, 0, keys[i].Xi.Cmp(save.Xi), "the share must change")
+		assert.True(t, crypto.ScalarBaseMult(tss.Edwards(), save.Xi).Equals(save.BigXj[i]))
+		for j := range refreshed {
+			assert.True(t, save.BigXj[j].Equals(refreshed[0].BigXj[j]), "all parties must agree on the public shares")
+		}
+	}
+
+	// PHASE: signing with the refreshed keys
+	signKeys, signPIDs := refreshed[:testThreshold+1], pIDs[:testThreshold+1]
+	p2pCtx = tss.NewPeerContext(signPIDs)
+	sigCh := make(chan *common.SignatureData, len(signPIDs))
+	msg := big.NewInt(200)
+	parties = make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		parties = append(parties, signing.NewLocalParty(msg, params, signKeys[i], outCh, sigCh))
+	}
+	var sig *common.SignatureData
+	done = make(chan struct{})
+	go func() {
+		for range signPIDs {
+			sig = <-sigCh
+		}
+		close(done)
+	}()
+	runParties(t, parties, outCh, errCh, done)
+
+	pk := edwards.PublicKey{
+		Curve: tss.Edwards(),
+		X:     keys[0].EDDSAPub.X(),
+		Y:     keys[0].EDDSAPub.Y(),
+	}
+	newSig, err := edwards.ParseSignature(sig.Signature)
+	assert.NoError(t, err)
+	ok := edwards.Verify(&pk, msg.Bytes(), newSig.R, newSig.S)
+	assert.True(t, ok, "eddsa verify must pass")
+}
@@ -0,0 +1,100 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	cmts "github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/crypto/vss"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// round 1 represents round 1 of the key refresh protocol, in which each party deals a Shamir sharing of zero
+func newRound1(params *tss.Parameters, save *keygen.LocalPartySaveData, temp *localTempData, out *tss.Outbox) tss.Round {
+	return &round1{
+		&base{params, save, temp, out, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	// every share of the key must be refreshed, otherwise the stale shares could no longer be combined with new ones
+	if round.PartyCount() != round.temp.committeeSize {
+		return round.WrapError(fmt.Errorf("all %d parties holding the key must take part in a refresh, got %d",
+			round.temp.committeeSize, round.PartyCount()))
+	}
+	if round.save.ShareID == nil || round.save.ShareID.Cmp(Pi.KeyInt()) != 0 {
+		return round.WrapError(errors.New("the key data does not belong to this party"), Pi)
+	}
+
+	round.temp.ssidNonce = round.SSIDNonce()
+	ssid, err := round.getSSID()
+	if err != nil {
+		return round.WrapError(errors.New("failed to generate ssid"))
+	}
+	round.temp.ssid = ssid
+
+	// 1. compute the vss shares of zero
+	ids := round.Parties().IDs().Keys()
+	vs, shares, err := vss.CreateZeroSharing(round.Rand(), round.Params().EC(), round.Threshold(), ids)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	round.temp.vs = vs
+	round.temp.shares = shares
+
+	// 2. make commitment -> (C, D)
+	pGFlat, err := crypto.FlattenECPoints(vs)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	cmt := cmts.NewHashCommitmentWithRand(round.Rand(), pGFlat...)
+	round.temp.deCommitPolyG = cmt.D
+
+	// 3. BROADCAST commitment
+	msg := NewRefreshRound1Message(Pi, cmt.C)
+	round.temp.refreshRound1Messages[i] = msg
+	round.out.Send(msg)
+	return nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*RefreshRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.refreshRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
@@ -0,0 +1,143 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package repair
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/vss"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// round 1 represents round 1 of the share repair protocol: each helper Pi splits its contribution
+// lambda_i(k_r) * x_i to the lost share x_r into random parts, one for each helper
+func newRound1(params *tss.Parameters, save *keygen.LocalPartySaveData, temp *localTempData, out *tss.Outbox) tss.Round {
+	return &round1{
+		&base{params, save, temp, out, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+	Ps := round.Parties().IDs()
+
+	if len(Ps) < round.Threshold()+2 {
+		return round.WrapError(errors.New("t+1 helpers are needed to repair a share"))
+	}
+	if round.isLost(i) {
+		// this party only receives in this protocol
+		round.save.ShareID = Pi.KeyInt()
+		return nil
+	}
+	if round.save.Xi == nil || round.save.ShareID == nil || round.save.ShareID.Cmp(Pi.KeyInt()) != 0 {
+		return round.WrapError(errors.New("this helper does not hold its share of the key"), Pi)
+	}
+
+	// 1. zeta_i = lambda_i(k_r) * x_i over the set of helpers
+	helperKs := round.helperKs()
+	lambda, err := vss.LagrangeCoefficient(round.EC(), helperKs, round.helperPos(i), round.temp.ks[round.temp.lostIdx])
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	zeta := new(big.Int).Mul(lambda, round.save.Xi)
+
+	// 2. split zeta_i into one random part for each helper and commit to the parts
+	parts, err := vss.SplitAdditive(round.Rand(), round.EC(), zeta, len(helperKs))
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	commitments := make([]*crypto.ECPoint, len(parts))
+	for k, part := range parts {
+		commitments[k] = crypto.ScalarBaseMult(round.EC(), part)
+	}
+
+	// 3. BROADCAST the commitments
+	r1msg1, err := NewRepairRound1Message1(Pi, commitments)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	round.temp.repairRound1Message1s[i] = r1msg1
+	round.out.Send(r1msg1)
+
+	// 4. p2p send each part to its helper
+	for j, Pj := range Ps {
+		if round.isLost(j) {
+			continue
+		}
+		r1msg2 := NewRepairRound1Message2(Pj, Pi, parts[round.helperPos(j)])
+		if j == i {
+			round.temp.repairRound1Message2s[j] = r1msg2
+			continue
+		}
+		round.out.Send(r1msg2)
+	}
+	return nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*RepairRound1Message1); ok {
+		return msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*RepairRound1Message2); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.repairRound1Message1s {
+		if round.ok[j] {
+			continue
+		}
+		// the lost party sends nothing
+		if round.isLost(j) {
+			round.ok[j] = true
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		// only the helpers receive the parts
+		if !round.isLost(round.PartyID().Index) {
+			msg2 := round.temp.repairRound1Message2s[j]
+			if msg2 == nil || !round.CanAccept(msg2) {
+				return false, nil
+			}
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
+
+// ----- //
+
+// helperKs returns the party keys of the helpers in party order
+func (round *base) helperKs() []*big.Int {
+	ks := make([]*big.Int, 0, len(round.temp.ks)-1)
+	for j, k := range round.temp.ks {
+		if round.isLost(j) {
+			continue
+		}
+		ks = append(ks, k)
+	}
+	return ks
+}
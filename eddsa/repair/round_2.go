@@ -0,0 +1,149 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package repair
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/vss"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+	Ps := round.Parties().IDs()
+	ec := round.EC()
+	helperKs := round.helperKs()
+
+	// 1. verify that the parts of each helper Pj add up to lambda_j(k_r) * Xj
+	var multiErr error
+	culprits := make([]*tss.PartyID, 0, len(Ps))
+	for j, Pj := range Ps {
+		if round.isLost(j) {
+			continue
+		}
+		if err := round.verifyCommitments(j, helperKs); err != nil {
+			multiErr = multierror.Append(multiErr, err)
+			culprits = append(culprits, Pj)
+		}
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(multiErr, culprits...)
+	}
+
+	// the lost party waits for the sums of the helpers
+	if round.isLost(i) {
+		return nil
+	}
+
+	// 2. verify the parts received from each helper against its commitments and sum them up
+	modN := common.ModInt(ec.Params().N)
+	sigma := big.NewInt(0)
+	for j, Pj := range Ps {
+		if round.isLost(j) {
+			continue
+		}
+		part := round.temp.repairRound1Message2s[j].Content().(*RepairRound1Message2).UnmarshalShare()
+		if !crypto.ScalarBaseMult(ec, part).Equals(round.temp.commitments[j][round.helperPos(i)]) {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		sigma = modN.Add(sigma, part)
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(errors.New("a part did not match its commitment"), culprits...)
+	}
+
+	// 3. p2p send the sum to the lost party
+	round.out.Send(NewRepairRound2Message(Ps[round.temp.lostIdx], Pi, sigma))
+
+	// this helper is done and keeps its save data as it was
+	for j := range round.ok {
+		round.ok[j] = true
+	}
+	round.out.Finish(round.save)
+	return nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*RepairRound2Message); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.repairRound2Messages {
+		if round.ok[j] {
+			continue
+		}
+		if round.isLost(j) {
+			round.ok[j] = true
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	// the helpers have finished
+	if !round.isLost(round.PartyID().Index) {
+		return nil
+	}
+	return &round3{round}
+}
+
+// ----- //
+
+// verifyCommitments checks that the commitments of helper Pj add up to lambda_j(k_r) * Xj and stores them
+func (round *round2) verifyCommitments(j int, helperKs []*big.Int) error {
+	ec := round.EC()
+	r1msg1 := round.temp.repairRound1Message1s[j].Content().(*RepairRound1Message1)
+	commitments, err := r1msg1.UnmarshalCommitments(ec)
+	if err != nil {
+		return err
+	}
+	for k, commitment := range commitments {
+		commitments[k] = commitment.EightInvEight()
+	}
+	if len(commitments) != len(helperKs) {
+		return errors.New("unexpected number of commitments")
+	}
+	lambda, err := vss.LagrangeCoefficient(ec, helperKs, round.helperPos(j), round.temp.ks[round.temp.lostIdx])
+	if err != nil {
+		return err
+	}
+	sum := commitments[0]
+	for _, commitment := range commitments[1:] {
+		if sum, err = sum.Add(commitment); err != nil {
+			return err
+		}
+	}
+	if !sum.Equals(round.temp.bigXs[j].ScalarMult(lambda)) {
+		return errors.New("the commitments do not add up to the public share")
+	}
+	round.temp.commitments[j] = commitments
+	return nil
+}
@@ -0,0 +1,181 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package resharing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	cmt "github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/crypto/sealed"
+	"github.com/bnb-chain/tss-lib/v2/crypto/vss"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	// CheckpointContentType identifies sealed containers holding checkpoints of EdDSA re-sharing
+	CheckpointContentType = "eddsa-resharing-checkpoint"
+)
+
+type (
+	checkpoint struct {
+		Party    []byte
+		Messages [][][]byte
+		Temp     checkpointTemp
+		Save     keygen.LocalPartySaveData
+	}
+
+	checkpointTemp struct {
+		NewVs     vss.Vs
+		NewShares vss.Shares
+		VD        cmt.HashDeCommitment
+		NewXi     *big.Int
+		NewKs     []*big.Int
+		NewBigXjs []*crypto.ECPoint
+	}
+)
+
+// EnableCheckpoints makes the party seal its state with `kek` and pass it to `store` once it has started each round
+// after the first, as described in tss.CheckpointFunc. The epoch in `epochs` is raised before each checkpoint, and the
+// messages of a round are not sent if that or `store` fails. Call it before Start.
+func (p *LocalParty) EnableCheckpoints(
+	kek sealed.KeyEncryptionKey,
+	epochs tss.CheckpointEpochs,
+	store func(checkpoint []byte) error,
+) {
+	tss.SetCheckpointFunc(p, p.params.OldAndNewParties(), epochs, func(step int, state []byte) error {
+		bz, err := p.marshalCheckpoint(state)
+		if err != nil {
+			return err
+		}
+		curve, _ := tss.GetCurveName(p.params.EC())
+		md := sealed.Metadata{
+			ContentType: CheckpointContentType,
+			Curve:       curve,
+			PublicKey:   p.input.EDDSAPub,
+			Epoch:       uint64(step),
+		}
+		container, err := sealed.Seal(bz, md, kek)
+		if err != nil {
+			return err
+		}
+		return store(container)
+	})
+}
+
+// ResumeLocalParty rebuilds a party from the latest checkpoint it saved with EnableCheckpoints and the `epochs` given
+// to it, using the arguments that the party was created with. Start fails unless the checkpoint is still the latest one
+// and has not been resumed from before. Start the party to continue the session.
+func ResumeLocalParty(
+	container []byte,
+	kek sealed.KeyEncryptionKey,
+	epochs tss.CheckpointEpochs,
+	params *tss.ReSharingParameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- *keygen.LocalPartySaveData,
+) (tss.Party, error) {
+	bz, md, err := sealed.Open(container, kek)
+	if err != nil {
+		return nil, err
+	}
+	if md.ContentType != CheckpointContentType {
+		return nil, fmt.Errorf("the container holds %q, not %q", md.ContentType, CheckpointContentType)
+	}
+	if curve, _ := tss.GetCurveName(params.EC()); md.Curve != curve || (md.PublicKey == nil) != (key.EDDSAPub == nil) ||
+		(md.PublicKey != nil && !md.PublicKey.Equals(key.EDDSAPub)) {
+		return nil, errors.New("the checkpoint was taken with another key")
+	}
+	cp := new(checkpoint)
+	if err = json.Unmarshal(bz, cp); err != nil {
+		return nil, err
+	}
+	p := NewLocalParty(params, key, out, end).(*LocalParty)
+	if err = p.unmarshalCheckpoint(cp); err != nil {
+		return nil, err
+	}
+	if err = tss.ResumeParty(p, params.OldAndNewParties(), epochs, cp.Party); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ----- //
+
+// CheckpointState is inherited by other rounds
+func (round *base) CheckpointState() (int, [][]bool) {
+	return round.number, [][]bool{round.oldOK, round.newOK}
+}
+
+// RestoreState is inherited by other rounds
+func (round *base) RestoreState(number int, ok [][]bool) error {
+	if len(ok) != 2 || len(ok[0]) != len(round.oldOK) || len(ok[1]) != len(round.newOK) {
+		return errors.New("the checkpoint has a round state of an unexpected size")
+	}
+	copy(round.oldOK, ok[0])
+	copy(round.newOK, ok[1])
+	round.number, round.started = number, true
+	return nil
+}
+
+// slots returns the message stores along with the committee that sends the messages kept in each
+func (p *LocalParty) slots() ([]*[]tss.ParsedMessage, []tss.SortedPartyIDs) {
+	s := &p.temp.localMessageStore
+	oldIDs, newIDs := p.params.OldParties().IDs(), p.params.NewParties().IDs()
+	return []*[]tss.ParsedMessage{&s.dgRound1Messages, &s.dgRound2Messages, &s.dgRound3Message1s, &s.dgRound3Message2s, &s.dgRound4Messages},
+		[]tss.SortedPartyIDs{oldIDs, newIDs, oldIDs, oldIDs, newIDs}
+}
+
+func (p *LocalParty) marshalCheckpoint(state []byte) ([]byte, error) {
+	cp := &checkpoint{
+		Party: state,
+		Temp: checkpointTemp{
+			NewVs:     p.temp.NewVs,
+			NewShares: p.temp.NewShares,
+			VD:        p.temp.VD,
+			NewXi:     p.temp.newXi,
+			NewKs:     p.temp.newKs,
+			NewBigXjs: p.temp.newBigXjs,
+		},
+		Save: p.save,
+	}
+	slots, _ := p.slots()
+	for _, slot := range slots {
+		bzs, err := tss.MarshalMessages(*slot)
+		if err != nil {
+			return nil, err
+		}
+		cp.Messages = append(cp.Messages, bzs)
+	}
+	return json.Marshal(cp)
+}
+
+func (p *LocalParty) unmarshalCheckpoint(cp *checkpoint) error {
+	slots, committees := p.slots()
+	if len(cp.Messages) != len(slots) {
+		return errors.New("the checkpoint has an unexpected number of message stores")
+	}
+	for i, slot := range slots {
+		msgs, err := tss.UnmarshalMessages(cp.Messages[i], committees[i])
+		if err != nil {
+			return err
+		}
+		if len(msgs) != len(*slot) {
+			return errors.New("the checkpoint has a message store of an unexpected size")
+		}
+		*slot = msgs
+	}
+	t := cp.Temp
+	p.temp.NewVs, p.temp.NewShares, p.temp.VD = t.NewVs, t.NewShares, t.VD
+	p.temp.newXi, p.temp.newKs, p.temp.newBigXjs = t.NewXi, t.NewKs, t.NewBigXjs
+	p.save = cp.Save
+	return nil
+}
@@ -30,10 +30,6 @@ type (
 
 		temp        localTempData
 		input, save keygen.LocalPartySaveData
-
-		// outbound messaging
-		out chan<- tss.Message
-		end chan<- *keygen.LocalPartySaveData
 	}
 
 	localMessageStore struct {
@@ -75,13 +71,11 @@ func NewLocalParty(
 		subset = keygen.BuildLocalSaveDataSubset(key, params.OldParties().IDs())
 	}
 	p := &LocalParty{
-		BaseParty: new(tss.BaseParty),
+		BaseParty: tss.NewBaseParty(params.Parameters, out, func(result interface{}) { end <- result.(*keygen.LocalPartySaveData) }),
 		params:    params,
 		temp:      localTempData{},
 		input:     subset,
 		save:      keygen.NewLocalPartySaveData(params.NewPartyCount()),
-		out:       out,
-		end:       end,
 	}
 	// msgs init
 	p.temp.dgRound1Messages = make([]tss.ParsedMessage, oldPartyCount)          // from t+1 of Old Committee
@@ -93,8 +87,29 @@ func NewLocalParty(
 	return p
 }
 
+// NewLocalPartyFromSaveData returns a party of the old committee for re-sharing the key encoded in `saveData` by
+// keygen.SavedKey.Marshal. The curve, threshold and party IDs of the old committee are read from the save data;
+// `oldParties` are the members of the old committee which take part.
+func NewLocalPartyFromSaveData(
+	saveData []byte,
+	oldParties, newParties tss.SortedPartyIDs,
+	newThreshold int,
+	out chan<- tss.Message,
+	end chan<- *keygen.LocalPartySaveData,
+) (tss.Party, error) {
+	key, err := keygen.UnmarshalSavedKey(saveData)
+	if err != nil {
+		return nil, err
+	}
+	params, err := key.ReSharingParameters(oldParties, newParties, newThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return NewLocalParty(params, key.Data, out, end), nil
+}
+
 func (p *LocalParty) FirstRound() tss.Round {
-	return newRound1(p.params, &p.input, &p.save, &p.temp, p.out, p.end)
+	return newRound1(p.params, &p.input, &p.save, &p.temp, p.Outbox())
 }
 
 func (p *LocalParty) Start() *tss.Error {
@@ -102,11 +117,15 @@ func (p *LocalParty) Start() *tss.Error {
 }
 
 func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
-	return tss.BaseUpdate(p, msg, TaskName)
+	opened, openErr := p.params.OpenMessage(msg)
+	if openErr != nil {
+		return false, p.WrapError(openErr)
+	}
+	return tss.BaseUpdate(p, opened, TaskName)
 }
 
 func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
-	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	msg, err := tss.ParseSessionWireMessage(wireBytes, from, isBroadcast, p.params.SessionID())
 	if err != nil {
 		return false, p.WrapError(err)
 	}
@@ -129,6 +148,9 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
 			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
 	}
+	if err := p.params.ValidateSessionID(msg); err != nil {
+		return false, p.WrapError(err, msg.GetFrom())
+	}
 	return true, nil
 }
 
@@ -140,7 +162,7 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	fromPIdx := msg.GetFrom().Index
 
 	// switch/case is necessary to store any messages beyond current round
-	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	// replays and equivocations are caught by tss.BaseUpdate before this point. we expect the caller to apply spoofing protection.
 	switch msg.Content().(type) {
 	case *DGRound1Message:
 		p.temp.dgRound1Messages[fromPIdx] = msg
@@ -153,7 +175,7 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	case *DGRound4Message:
 		p.temp.dgRound4Messages[fromPIdx] = msg
 	default: // unrecognised message, just ignore!
-		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		p.params.Logger(TaskName, 0).Warn("unrecognised message ignored", common.StringField("msg", msg.String()))
 		return false, nil
 	}
 	return true, nil
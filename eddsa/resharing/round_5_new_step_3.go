@@ -33,7 +33,7 @@ func (round *round5) Start() *tss.Error {
 		round.input.Xi.SetInt64(0)
 	}
 
-	round.end <- round.save
+	round.out.Finish(round.save)
 	return nil
 }
 
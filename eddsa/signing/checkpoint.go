@@ -0,0 +1,183 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	cmt "github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/crypto/sealed"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	// CheckpointContentType identifies sealed containers holding checkpoints of EdDSA signing
+	CheckpointContentType = "eddsa-signing-checkpoint"
+)
+
+type (
+	checkpoint struct {
+		Party    []byte
+		Messages [][][]byte
+		Temp     checkpointTemp
+	}
+
+	checkpointTemp struct {
+		Wi, M, Ri *big.Int
+		PointRi   *crypto.ECPoint
+		DeCommit  cmt.HashDeCommitment
+		Cjs       []*big.Int
+		Si        *[32]byte
+		R         *big.Int
+		SSID      []byte
+		SSIDNonce *big.Int
+	}
+)
+
+// EnableCheckpoints makes the party seal its state with `kek` and pass it to `store` once it has started each round
+// after the first, as described in tss.CheckpointFunc. The epoch in `epochs` is raised before each checkpoint, and the
+// messages of a round are not sent if that or `store` fails. Call it before Start.
+func (p *LocalParty) EnableCheckpoints(
+	kek sealed.KeyEncryptionKey,
+	epochs tss.CheckpointEpochs,
+	store func(checkpoint []byte) error,
+) {
+	tss.SetCheckpointFunc(p, p.params.Parties().IDs(), epochs, func(step int, state []byte) error {
+		bz, err := p.marshalCheckpoint(state)
+		if err != nil {
+			return err
+		}
+		curve, _ := tss.GetCurveName(p.params.EC())
+		md := sealed.Metadata{
+			ContentType: CheckpointContentType,
+			Curve:       curve,
+			PublicKey:   p.keys.EDDSAPub,
+			Epoch:       uint64(step),
+		}
+		container, err := sealed.Seal(bz, md, kek)
+		if err != nil {
+			return err
+		}
+		return store(container)
+	})
+}
+
+// ResumeLocalParty rebuilds a party from the latest checkpoint it saved with EnableCheckpoints and the `epochs` given
+// to it. The other arguments must be the ones the party was created with; a different message or key is rejected so
+// that the nonces of the session are never used to sign anything else. Start fails unless the checkpoint is still the
+// latest one and has not been resumed from before. Start the party to continue the session.
+func ResumeLocalParty(
+	container []byte,
+	kek sealed.KeyEncryptionKey,
+	epochs tss.CheckpointEpochs,
+	msg *big.Int,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- *common.SignatureData,
+) (tss.Party, error) {
+	bz, md, err := sealed.Open(container, kek)
+	if err != nil {
+		return nil, err
+	}
+	if md.ContentType != CheckpointContentType {
+		return nil, fmt.Errorf("the container holds %q, not %q", md.ContentType, CheckpointContentType)
+	}
+	if curve, _ := tss.GetCurveName(params.EC()); md.Curve != curve || md.PublicKey == nil || !md.PublicKey.Equals(key.EDDSAPub) {
+		return nil, errors.New("the checkpoint was taken with another key")
+	}
+	cp := new(checkpoint)
+	if err = json.Unmarshal(bz, cp); err != nil {
+		return nil, err
+	}
+	if cp.Temp.M == nil || msg == nil || cp.Temp.M.Cmp(msg) != 0 {
+		return nil, errors.New("the checkpoint was taken while signing another message")
+	}
+	p := NewLocalParty(msg, params, key, out, end).(*LocalParty)
+	if err = p.unmarshalCheckpoint(cp); err != nil {
+		return nil, err
+	}
+	if err = tss.ResumeParty(p, params.Parties().IDs(), epochs, cp.Party); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ----- //
+
+// CheckpointState is inherited by other rounds
+func (round *base) CheckpointState() (int, [][]bool) {
+	return round.number, [][]bool{round.ok}
+}
+
+// RestoreState is inherited by other rounds
+func (round *base) RestoreState(number int, ok [][]bool) error {
+	if len(ok) != 1 || len(ok[0]) != len(round.ok) {
+		return errors.New("the checkpoint has a round state of an unexpected size")
+	}
+	copy(round.ok, ok[0])
+	round.number, round.started = number, true
+	return nil
+}
+
+func (s *localMessageStore) slots() []*[]tss.ParsedMessage {
+	return []*[]tss.ParsedMessage{&s.signRound1Messages, &s.signRound2Messages, &s.signRound3Messages}
+}
+
+func (p *LocalParty) marshalCheckpoint(state []byte) ([]byte, error) {
+	cp := &checkpoint{
+		Party: state,
+		Temp: checkpointTemp{
+			Wi:        p.temp.wi,
+			M:         p.temp.m,
+			Ri:        p.temp.ri,
+			PointRi:   p.temp.pointRi,
+			DeCommit:  p.temp.deCommit,
+			Cjs:       p.temp.cjs,
+			Si:        p.temp.si,
+			R:         p.temp.r,
+			SSID:      p.temp.ssid,
+			SSIDNonce: p.temp.ssidNonce,
+		},
+	}
+	for _, slot := range p.temp.slots() {
+		bzs, err := tss.MarshalMessages(*slot)
+		if err != nil {
+			return nil, err
+		}
+		cp.Messages = append(cp.Messages, bzs)
+	}
+	return json.Marshal(cp)
+}
+
+func (p *LocalParty) unmarshalCheckpoint(cp *checkpoint) error {
+	slots := p.temp.slots()
+	if len(cp.Messages) != len(slots) {
+		return errors.New("the checkpoint has an unexpected number of message stores")
+	}
+	for i, slot := range slots {
+		msgs, err := tss.UnmarshalMessages(cp.Messages[i], p.params.Parties().IDs())
+		if err != nil {
+			return err
+		}
+		if len(msgs) != len(*slot) {
+			return errors.New("the checkpoint has a message store of an unexpected size")
+		}
+		*slot = msgs
+	}
+	t := cp.Temp
+	p.temp.wi, p.temp.ri, p.temp.pointRi, p.temp.deCommit = t.Wi, t.Ri, t.PointRi, t.DeCommit
+	p.temp.cjs, p.temp.si, p.temp.r = t.Cjs, t.Si, t.R
+	p.temp.ssid, p.temp.ssidNonce = t.SSID, t.SSIDNonce
+	return nil
+}
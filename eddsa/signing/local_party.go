@@ -31,10 +31,6 @@ type (
 		keys keygen.LocalPartySaveData
 		temp localTempData
 		data *common.SignatureData
-
-		// outbound messaging
-		out chan<- tss.Message
-		end chan<- *common.SignatureData
 	}
 
 	localMessageStore struct {
@@ -74,13 +70,11 @@ func NewLocalParty(
 ) tss.Party {
 	partyCount := len(params.Parties().IDs())
 	p := &LocalParty{
-		BaseParty: new(tss.BaseParty),
+		BaseParty: tss.NewBaseParty(params, out, func(result interface{}) { end <- result.(*common.SignatureData) }),
 		params:    params,
 		keys:      keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs()),
 		temp:      localTempData{},
 		data:      &common.SignatureData{},
-		out:       out,
-		end:       end,
 	}
 	// msgs init
 	p.temp.signRound1Messages = make([]tss.ParsedMessage, partyCount)
@@ -93,8 +87,28 @@ func NewLocalParty(
 	return p
 }
 
+// NewLocalPartyFromSaveData returns a signing party for the key encoded in `saveData` by keygen.SavedKey.Marshal.
+// The curve, threshold and party IDs are read from the save data; `signers` are the parties which take part.
+func NewLocalPartyFromSaveData(
+	msg *big.Int,
+	saveData []byte,
+	signers []*tss.PartyID,
+	out chan<- tss.Message,
+	end chan<- *common.SignatureData,
+) (tss.Party, error) {
+	key, err := keygen.UnmarshalSavedKey(saveData)
+	if err != nil {
+		return nil, err
+	}
+	params, err := key.Parameters(signers...)
+	if err != nil {
+		return nil, err
+	}
+	return NewLocalParty(msg, params, key.Data, out, end), nil
+}
+
 func (p *LocalParty) FirstRound() tss.Round {
-	return newRound1(p.params, &p.keys, p.data, &p.temp, p.out, p.end)
+	return newRound1(p.params, &p.keys, p.data, &p.temp, p.Outbox())
 }
 
 func (p *LocalParty) Start() *tss.Error {
@@ -111,11 +125,15 @@ func (p *LocalParty) Start() *tss.Error {
 }
 
 func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
-	return tss.BaseUpdate(p, msg, TaskName)
+	opened, openErr := p.params.OpenMessage(msg)
+	if openErr != nil {
+		return false, p.WrapError(openErr)
+	}
+	return tss.BaseUpdate(p, opened, TaskName)
 }
 
 func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
-	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	msg, err := tss.ParseSessionWireMessage(wireBytes, from, isBroadcast, p.params.SessionID())
 	if err != nil {
 		return false, p.WrapError(err)
 	}
@@ -131,6 +149,9 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
 			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
 	}
+	if err := p.params.ValidateSessionID(msg); err != nil {
+		return false, p.WrapError(err, msg.GetFrom())
+	}
 	return p.BaseParty.ValidateMessage(msg)
 }
 
@@ -142,7 +163,7 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	fromPIdx := msg.GetFrom().Index
 
 	// switch/case is necessary to store any messages beyond current round
-	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	// replays and equivocations are caught by tss.BaseUpdate before this point. we expect the caller to apply spoofing protection.
 	switch msg.Content().(type) {
 	case *SignRound1Message:
 		p.temp.signRound1Messages[fromPIdx] = msg
@@ -154,7 +175,7 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 		p.temp.signRound3Messages[fromPIdx] = msg
 
 	default: // unrecognised message, just ignore!
-		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		p.params.Logger(TaskName, 0).Warn("unrecognised message ignored", common.StringField("msg", msg.String()))
 		return false, nil
 	}
 	return true, nil
@@ -7,10 +7,15 @@
 package signing
 
 import (
+	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/agl/ed25519/edwards25519"
 	"github.com/decred/dcrd/dcrec/edwards/v2"
@@ -18,6 +23,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/bnb-chain/tss-lib/v2/common"
+	cmt "github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/crypto/sealed"
 	"github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
 	"github.com/bnb-chain/tss-lib/v2/test"
 	"github.com/bnb-chain/tss-lib/v2/tss"
@@ -142,3 +149,955 @@ signing:
 		}
 	}
 }
+
+func TestE2EConcurrentFromSaveData(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// PHASE: encode the save data of the signers
+	signers := pIDs[:testThreshold+1]
+	files := make([][]byte, len(signers))
+	for i, Pi := range signers {
+		params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), Pi, len(pIDs), testThreshold)
+		saved, err := keygen.NewSavedKey(params, keys[i], 0)
+		assert.NoError(t, err)
+		files[i], err = saved.Marshal()
+		assert.NoError(t, err)
+	}
+
+	// PHASE: signing
+	errCh := make(chan *tss.Error, len(signers))
+	outCh := make(chan tss.Message, len(signers))
+	endCh := make(chan *common.SignatureData, len(signers))
+
+	msg := big.NewInt(200)
+	parties := make([]tss.Party, 0, len(signers))
+	for i := range signers {
+		P, err := NewLocalPartyFromSaveData(msg, files[i], signers, outCh, endCh)
+		assert.NoError(t, err)
+		parties = append(parties, P)
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	var sig *common.SignatureData
+	for ended := 0; ended < len(signers); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go test.SharedPartyUpdater(P, msg, errCh)
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case sig = <-endCh:
+			ended++
+		}
+	}
+
+	pk := edwards.PublicKey{
+		Curve: tss.Edwards(),
+		X:     keys[0].EDDSAPub.X(),
+		Y:     keys[0].EDDSAPub.Y(),
+	}
+	newSig, err := edwards.ParseSignature(sig.Signature)
+	assert.NoError(t, err)
+	assert.True(t, edwards.Verify(&pk, msg.Bytes(), newSig.R, newSig.S), "eddsa verify must pass")
+}
+
+func TestE2EConcurrentWithSessionID(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	sessionID := []byte("signing-session-1")
+	msg := big.NewInt(200)
+	parties := make([]*LocalParty, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		params.SetSessionID(sessionID)
+		parties = append(parties, NewLocalParty(msg, params, keys[i], outCh, endCh).(*LocalParty))
+	}
+	for _, P := range parties {
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	var sig *common.SignatureData
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			assert.Equal(t, sessionID, msg.WireMsg().GetSessionId(), "outgoing messages must carry the session ID")
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index == msg.GetFrom().Index {
+						continue
+					}
+					go test.SharedPartyUpdater(P, msg, errCh)
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case sig = <-endCh:
+			ended++
+		}
+	}
+	for _, P := range parties {
+		assert.Equal(t, parties[0].temp.ssid, P.temp.ssid, "all parties must derive the same SSID")
+	}
+
+	pk := edwards.PublicKey{
+		Curve: tss.Edwards(),
+		X:     keys[0].EDDSAPub.X(),
+		Y:     keys[0].EDDSAPub.Y(),
+	}
+	newSig, err := edwards.ParseSignature(sig.Signature)
+	assert.NoError(t, err)
+	assert.True(t, edwards.Verify(&pk, msg.Bytes(), newSig.R, newSig.S), "eddsa verify must pass")
+}
+
+func TestSessionIDMismatch(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	newParty := func(i int, sessionID []byte) *LocalParty {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		params.SetSessionID(sessionID)
+		return NewLocalParty(big.NewInt(200), params, keys[i], outCh, endCh).(*LocalParty)
+	}
+	P0, P1 := newParty(0, []byte("session-a")), newParty(1, []byte("session-b"))
+	assert.Nil(t, P0.Start())
+	assert.Nil(t, P1.Start())
+	assert.NotEqual(t, P0.temp.ssid, P1.temp.ssid, "parties in different sessions must not share an SSID")
+
+	r1msg := <-outCh
+	if r1msg.GetFrom().Index != 0 {
+		r1msg = <-outCh
+	}
+	ok, tssErr := P1.Update(r1msg.(tss.ParsedMessage))
+	assert.False(t, ok)
+	if assert.NotNil(t, tssErr, "a message from another session must be rejected") {
+		assert.Equal(t, signPIDs[0], tssErr.Culprits()[0])
+	}
+
+	// a message without a session ID is rejected as well
+	unset := newParty(2, nil)
+	assert.Nil(t, unset.Start())
+	var unsetMsg tss.Message
+	for unsetMsg == nil {
+		if m := <-outCh; m.GetFrom().Index == 2 {
+			unsetMsg = m
+		}
+	}
+	assert.Empty(t, unsetMsg.WireMsg().GetSessionId())
+	_, tssErr = P1.Update(unsetMsg.(tss.ParsedMessage))
+	assert.NotNil(t, tssErr, "a message without a session ID must be rejected")
+}
+
+func TestDuplicateAndEquivocation(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	newParty := func(i int) *LocalParty {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		return NewLocalParty(big.NewInt(200), params, keys[i], outCh, endCh).(*LocalParty)
+	}
+	P0, P1 := newParty(0), newParty(1)
+	assert.Nil(t, P0.Start())
+	assert.Nil(t, P1.Start())
+	r1msg := <-outCh
+	if r1msg.GetFrom().Index != 0 {
+		r1msg = <-outCh
+	}
+
+	ok, tssErr := P1.Update(r1msg.(tss.ParsedMessage))
+	assert.True(t, ok)
+	assert.Nil(t, tssErr)
+
+	// an at-least-once transport may deliver the same message again
+	ok, tssErr = P1.Update(r1msg.(tss.ParsedMessage))
+	assert.True(t, ok)
+	assert.Nil(t, tssErr, "a duplicate message must be ignored")
+
+	// a different message in the same slot is an equivocation by its sender
+	conflicting := NewSignRound1Message(signPIDs[0], cmt.HashCommitment(big.NewInt(1)))
+	ok, tssErr = P1.Update(conflicting)
+	assert.False(t, ok)
+	if assert.NotNil(t, tssErr, "a conflicting message must be rejected") {
+		assert.Equal(t, []*tss.PartyID{signPIDs[0]}, tssErr.Culprits())
+	}
+	assert.Equal(t, r1msg, P1.temp.signRound1Messages[0], "the first message must be kept")
+}
+
+// signWithEcho runs a signing session with echo broadcast enabled. `tamper` may replace a message before it is
+// delivered to a party, to simulate a sender that equivocates over an unreliable broadcast channel.
+func signWithEcho(t *testing.T, tamper func(msg tss.Message, to *tss.PartyID) tss.Message) (*common.SignatureData, *tss.Error) {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		params.SetEchoBroadcast()
+		parties = append(parties, NewLocalParty(big.NewInt(200), params, keys[i], outCh, endCh))
+	}
+	for _, P := range parties {
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	var sig *common.SignatureData
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-errCh:
+			return nil, err
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				dest = signPIDs
+			}
+			for _, Pj := range dest {
+				if Pj.Index == msg.GetFrom().Index {
+					continue
+				}
+				go test.SharedPartyUpdater(parties[Pj.Index], tamper(msg, Pj), errCh)
+			}
+		case sig = <-endCh:
+			ended++
+		}
+	}
+	return sig, nil
+}
+
+func TestE2EConcurrentWithEchoBroadcast(t *testing.T) {
+	setUp("info")
+
+	sig, err := signWithEcho(t, func(msg tss.Message, _ *tss.PartyID) tss.Message { return msg })
+	assert.Nil(t, err)
+	assert.NotNil(t, sig)
+}
+
+func TestEchoBroadcastDetectsEquivocation(t *testing.T) {
+	setUp("info")
+
+	// party 0 sends party 2 a different round 1 commitment than everyone else
+	_, err := signWithEcho(t, func(msg tss.Message, to *tss.PartyID) tss.Message {
+		if _, ok := msg.(tss.ParsedMessage).Content().(*SignRound1Message); ok && msg.GetFrom().Index == 0 && to.Index == 2 {
+			return NewSignRound1Message(msg.GetFrom(), cmt.HashCommitment(big.NewInt(1)))
+		}
+		return msg
+	})
+	if assert.NotNil(t, err, "an inconsistent broadcast must abort the protocol") {
+		assert.Empty(t, err.Culprits(), "an unsigned echo does not prove who lied")
+		assert.Equal(t, 1, err.Round())
+	}
+}
+
+// signWithSeed signs with parties drawing their randomness from readers seeded with `seed`, and returns the
+// signature and the messages sent by each party
+func signWithSeed(t *testing.T, seed string) (*common.SignatureData, [][][]byte) {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		params.SetRand(common.NewDeterministicReader([]byte(fmt.Sprintf("%s/%d", seed, i))))
+		parties = append(parties, NewLocalParty(big.NewInt(200), params, keys[i], outCh, endCh))
+	}
+	for _, P := range parties {
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	sent := make([][][]byte, len(signPIDs))
+	var sig *common.SignatureData
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			bz, _, err := msg.WireBytes()
+			assert.NoError(t, err)
+			sent[msg.GetFrom().Index] = append(sent[msg.GetFrom().Index], bz)
+			dest := msg.GetTo()
+			if dest == nil {
+				dest = signPIDs
+			}
+			for _, Pj := range dest {
+				if Pj.Index == msg.GetFrom().Index {
+					continue
+				}
+				go test.SharedPartyUpdater(parties[Pj.Index], msg, errCh)
+			}
+		case sig = <-endCh:
+			ended++
+		}
+	}
+	return sig, sent
+}
+
+func TestE2EConcurrentWithSeededRandomness(t *testing.T) {
+	setUp("info")
+
+	sig1, sent1 := signWithSeed(t, "seed")
+	sig2, sent2 := signWithSeed(t, "seed")
+	assert.Equal(t, sig1.Signature, sig2.Signature, "the same seeds must give the same signature")
+	assert.Equal(t, sent1, sent2, "the same seeds must give the same messages")
+
+	sig3, _ := signWithSeed(t, "another seed")
+	assert.NotEqual(t, sig1.Signature, sig3.Signature)
+}
+
+func TestRoundTimeout(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	abortCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		params.SetContext(context.Background(), 500*time.Millisecond, abortCh)
+		parties = append(parties, NewLocalParty(big.NewInt(200), params, keys[i], outCh, endCh))
+	}
+	// the last party never starts, so the others time out waiting for it in round 1
+	silent := signPIDs[len(signPIDs)-1]
+	for _, P := range parties[:len(parties)-1] {
+		assert.Nil(t, P.Start())
+	}
+
+	var r1msg tss.Message
+	for aborted := 0; aborted < len(parties)-1; {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			r1msg = msg
+			for _, P := range parties[:len(parties)-1] {
+				go test.SharedPartyUpdater(P, msg, errCh)
+			}
+		case err := <-abortCh:
+			assert.Equal(t, 1, err.Round())
+			assert.Equal(t, []*tss.PartyID{silent}, err.Culprits())
+			aborted++
+		case <-endCh:
+			assert.FailNow(t, "no party should finish")
+		}
+	}
+
+	// an aborted party rejects further updates
+	_, tssErr := parties[0].Update(r1msg.(tss.ParsedMessage))
+	assert.NotNil(t, tssErr)
+}
+
+func TestContextCancel(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	abortCh := make(chan *tss.Error, 1)
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[0], len(signPIDs), testThreshold)
+	params.SetContext(ctx, 0, abortCh)
+	P := NewLocalParty(big.NewInt(200), params, keys[0], outCh, endCh)
+	assert.Nil(t, P.Start())
+	<-outCh
+
+	cancel()
+	err2 := <-abortCh
+	assert.ErrorIs(t, err2, context.Canceled)
+	assert.ElementsMatch(t, signPIDs[1:], err2.Culprits(), "the culprits are the parties the round was waiting for")
+	assert.Error(t, params.Context().Err(), "work in progress must be cancelled")
+}
+
+func TestE2EConcurrentWithCheckpoint(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	kek, err := sealed.NewAESKEK("test", make([]byte, 32))
+	assert.NoError(t, err)
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	// party 0 crashes while it saves its checkpoint in round 3, so what it sent in the round is dropped
+	errCrash := errors.New("crashed")
+	var saved []byte
+	epochs0 := new(test.CheckpointEpochs)
+	msg := big.NewInt(200)
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+	}
+	parties := make([]*LocalParty, 0, len(signPIDs))
+	for i := range signPIDs {
+		P := NewLocalParty(msg, newParams(i), keys[i], outCh, endCh).(*LocalParty)
+		store := func(checkpoint []byte) error { return nil }
+		epochs := new(test.CheckpointEpochs)
+		if i == 0 {
+			epochs = epochs0
+			store = func(checkpoint []byte) error {
+				saved = checkpoint
+				md, err := sealed.ReadMetadata(checkpoint)
+				assert.NoError(t, err)
+				if md.Epoch == 2 {
+					return errCrash
+				}
+				return nil
+			}
+		}
+		P.EnableCheckpoints(kek, epochs, store)
+		parties = append(parties, P)
+	}
+	for _, P := range parties {
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	// the transport keeps the messages for party 0, so that they can be delivered again after it restarts
+	var toParty0 []tss.Message
+	deliver := func(j int, msg tss.Message) {
+		if j == 0 {
+			toParty0 = append(toParty0, msg)
+		}
+		go test.SharedPartyUpdater(parties[j], msg, errCh)
+	}
+
+	var sig *common.SignatureData
+	resumed := false
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-errCh:
+			if !errors.Is(err, errCrash) {
+				assert.FailNow(t, err.Error())
+			}
+			if resumed {
+				// the crashed party rejects the messages that were on their way to it
+				continue
+			}
+			resumed = true
+
+			_, err2 := ResumeLocalParty(saved, kek, epochs0, big.NewInt(201), newParams(0), keys[0], outCh, endCh)
+			assert.Error(t, err2, "a checkpoint must not be resumed to sign another message")
+
+			P, err2 := ResumeLocalParty(saved, kek, epochs0, msg, newParams(0), keys[0], outCh, endCh)
+			assert.NoError(t, err2)
+			parties[0] = P.(*LocalParty)
+			assert.Nil(t, parties[0].Start())
+			for _, msg := range toParty0 {
+				go test.SharedPartyUpdater(parties[0], msg, errCh)
+			}
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for j := range parties {
+					if j != msg.GetFrom().Index {
+						deliver(j, msg)
+					}
+				}
+			} else {
+				deliver(dest[0].Index, msg)
+			}
+		case sig = <-endCh:
+			ended++
+		}
+	}
+	assert.True(t, resumed, "party 0 should have been resumed")
+
+	pk := edwards.PublicKey{
+		Curve: tss.Edwards(),
+		X:     keys[0].EDDSAPub.X(),
+		Y:     keys[0].EDDSAPub.Y(),
+	}
+	newSig, err := edwards.ParseSignature(sig.Signature)
+	assert.NoError(t, err)
+	assert.True(t, edwards.Verify(&pk, msg.Bytes(), newSig.R, newSig.S), "eddsa verify must pass")
+}
+
+func TestE2EConcurrentWithTranscriptReplay(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	seed := func(i int) []byte { return []byte(fmt.Sprintf("replay/%d", i)) }
+	transcripts := make([]*tss.Transcript, len(signPIDs))
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		params.SetRand(common.NewDeterministicReader(seed(i)))
+		transcripts[i] = tss.NewTranscript()
+		params.SetTranscript(transcripts[i])
+		parties = append(parties, NewLocalParty(big.NewInt(200), params, keys[i], outCh, endCh))
+	}
+	for _, P := range parties {
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				dest = signPIDs
+			}
+			for _, Pj := range dest {
+				if Pj.Index != msg.GetFrom().Index {
+					go test.SharedPartyUpdater(parties[Pj.Index], msg, errCh)
+				}
+			}
+		case <-endCh:
+			ended++
+		}
+	}
+	// the parties record that they finished once the update that ended them returns
+	for _, P := range parties {
+		P.WaitingFor()
+	}
+
+	bz, err := transcripts[0].Marshal()
+	assert.NoError(t, err)
+	replay := func(tr *tss.Transcript, seed []byte) *tss.ReplayResult {
+		params, err := tr.Parameters()
+		assert.NoError(t, err)
+		params.SetRand(common.NewDeterministicReader(seed))
+		out := make(chan tss.Message, len(signPIDs))
+		P := NewLocalParty(big.NewInt(200), params, keys[0], out, make(chan *common.SignatureData, 1))
+		res, err := tss.Replay(tr, P, out)
+		assert.NoError(t, err)
+		return res
+	}
+
+	// a fresh party given the same randomness goes through the same session
+	tr, err := tss.UnmarshalTranscript(bz)
+	assert.NoError(t, err)
+	assert.True(t, tr.Header.SeededRand)
+	params, err := tr.Parameters()
+	assert.NoError(t, err)
+	out := make(chan tss.Message, len(signPIDs))
+	_, err = tss.Replay(tr, NewLocalParty(big.NewInt(200), params, keys[0], out, make(chan *common.SignatureData, 1)), out)
+	assert.Error(t, err, "the seeded source is not recorded and must be given again")
+	res := replay(tr, seed(0))
+	assert.Nil(t, res.Err)
+	assert.True(t, res.Finished)
+	assert.Equal(t, -1, res.Diverged)
+
+	// with other randomness, it sends another commitment from the start
+	res = replay(tr, seed(1))
+	assert.Equal(t, 0, res.Diverged)
+
+	// a bad proof from party 1 is reproduced with its culprit
+	for i, e := range tr.Events {
+		if e.Kind != tss.TranscriptReceived {
+			continue
+		}
+		msg, err := tss.UnmarshalMessage(e.Message, signPIDs)
+		assert.NoError(t, err)
+		if r2msg, ok := msg.Content().(*SignRound2Message); ok && msg.GetFrom().Index == 1 {
+			r2msg.ProofT = new(big.Int).Add(new(big.Int).SetBytes(r2msg.ProofT), big.NewInt(1)).Bytes()
+			tr.Events[i].Message, err = tss.MarshalMessage(msg)
+			assert.NoError(t, err)
+		}
+	}
+	res = replay(tr, seed(0))
+	assert.False(t, res.Finished)
+	if assert.NotNil(t, res.Err) {
+		assert.Equal(t, 3, res.Err.Round())
+		if assert.Len(t, res.Err.Culprits(), 1) {
+			assert.Equal(t, signPIDs[1].Key, res.Err.Culprits()[0].Key)
+		}
+	}
+}
+
+// recordingObserver counts the events of every party by its id
+type recordingObserver struct {
+	tss.NoopObserver
+	mtx      sync.Mutex
+	started  map[string]int
+	rounds   map[string][]int
+	finished map[string][]int
+	sent     map[string]int
+	received map[string]int
+	waited   map[string]map[string]bool
+	proofs   map[string]int
+	errs     []*tss.Error
+	ended    map[string]int
+}
+
+func newRecordingObserver() *recordingObserver {
+	return &recordingObserver{
+		started:  make(map[string]int),
+		rounds:   make(map[string][]int),
+		finished: make(map[string][]int),
+		sent:     make(map[string]int),
+		received: make(map[string]int),
+		waited:   make(map[string]map[string]bool),
+		proofs:   make(map[string]int),
+		ended:    make(map[string]int),
+	}
+}
+
+func (o *recordingObserver) PartyStarted(party *tss.PartyID, task string) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	o.started[party.Id]++
+}
+
+func (o *recordingObserver) PartyFinished(party *tss.PartyID, task string, err *tss.Error, elapsed time.Duration) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	if err != nil {
+		o.errs = append(o.errs, err)
+		return
+	}
+	o.ended[party.Id]++
+}
+
+func (o *recordingObserver) RoundStarted(party *tss.PartyID, task string, round int) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	o.rounds[party.Id] = append(o.rounds[party.Id], round)
+}
+
+func (o *recordingObserver) RoundFinished(party *tss.PartyID, task string, round int, elapsed time.Duration) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	o.finished[party.Id] = append(o.finished[party.Id], round)
+}
+
+func (o *recordingObserver) MessageSent(party *tss.PartyID, msgType string, size int, to []*tss.PartyID) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	if 0 < size && to == nil {
+		o.sent[party.Id]++
+	}
+}
+
+func (o *recordingObserver) MessageReceived(party *tss.PartyID, msgType string, size int, from *tss.PartyID) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	if 0 < size {
+		o.received[party.Id]++
+	}
+}
+
+func (o *recordingObserver) PeerWaited(party *tss.PartyID, task string, round int, peer *tss.PartyID, waited time.Duration) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	if o.waited[party.Id] == nil {
+		o.waited[party.Id] = make(map[string]bool)
+	}
+	o.waited[party.Id][peer.Id] = true
+}
+
+func (o *recordingObserver) ProofGenerated(party *tss.PartyID, proof string, elapsed time.Duration) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	o.proofs["generated "+proof]++
+}
+
+func (o *recordingObserver) ProofVerified(party *tss.PartyID, proof string, ok bool, elapsed time.Duration) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	o.proofs[fmt.Sprintf("verified %s %v", proof, ok)]++
+}
+
+func TestE2EConcurrentWithObserver(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	observer := newRecordingObserver()
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		params.SetObserver(observer)
+		parties = append(parties, NewLocalParty(big.NewInt(200), params, keys[i], outCh, endCh))
+	}
+	for _, P := range parties {
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			for _, P := range parties {
+				if P.PartyID().Index != msg.GetFrom().Index {
+					go test.SharedPartyUpdater(P, msg, errCh)
+				}
+			}
+		case <-endCh:
+			ended++
+		}
+	}
+	// the parties report that they finished once the update that ended them returns
+	for _, P := range parties {
+		P.WaitingFor()
+	}
+
+	observer.mtx.Lock()
+	defer observer.mtx.Unlock()
+	n := len(signPIDs)
+	assert.Empty(t, observer.errs)
+	for _, Pi := range signPIDs {
+		assert.Equal(t, 1, observer.started[Pi.Id])
+		assert.Equal(t, 1, observer.ended[Pi.Id])
+		assert.Equal(t, []int{1, 2, 3, 4}, observer.rounds[Pi.Id])
+		assert.Equal(t, []int{1, 2, 3, 4}, observer.finished[Pi.Id])
+		assert.Equal(t, 3, observer.sent[Pi.Id], "every party broadcasts in rounds 1 to 3")
+		assert.Equal(t, 3*(n-1), observer.received[Pi.Id])
+		assert.Len(t, observer.waited[Pi.Id], n-1, "a party waits for each of its peers")
+		assert.False(t, observer.waited[Pi.Id][Pi.Id])
+	}
+	assert.Equal(t, map[string]int{
+		"generated " + tss.ProofSchnorr:          n,
+		"verified " + tss.ProofSchnorr + " true": n * (n - 1),
+	}, observer.proofs)
+}
+
+// recordingLogger keeps every line logged through it with its fields
+type recordingLogger struct {
+	lines  *[]map[string]string
+	mtx    *sync.Mutex
+	fields []common.Field
+}
+
+func (l recordingLogger) log(msg string, fields []common.Field) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	line := map[string]string{"msg": msg}
+	for _, f := range append(append([]common.Field{}, l.fields...), fields...) {
+		line[f.Key] = f.Value
+	}
+	*l.lines = append(*l.lines, line)
+}
+
+func (l recordingLogger) Debug(msg string, fields ...common.Field) { l.log(msg, fields) }
+func (l recordingLogger) Info(msg string, fields ...common.Field)  { l.log(msg, fields) }
+func (l recordingLogger) Warn(msg string, fields ...common.Field)  { l.log(msg, fields) }
+func (l recordingLogger) Error(msg string, fields ...common.Field) { l.log(msg, fields) }
+
+func (l recordingLogger) With(fields ...common.Field) common.StructuredLogger {
+	return recordingLogger{lines: l.lines, mtx: l.mtx, fields: append(append([]common.Field{}, l.fields...), fields...)}
+}
+
+func TestE2EConcurrentWithLogger(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	var lines []map[string]string
+	logger := recordingLogger{lines: &lines, mtx: new(sync.Mutex)}
+	sessionID := []byte("logger session")
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		params.SetSessionID(sessionID)
+		params.SetLogger(logger)
+		parties = append(parties, NewLocalParty(big.NewInt(200), params, keys[i], outCh, endCh))
+	}
+	for _, P := range parties {
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			for _, P := range parties {
+				if P.PartyID().Index != msg.GetFrom().Index {
+					go test.SharedPartyUpdater(P, msg, errCh)
+				}
+			}
+		case <-endCh:
+			ended++
+		}
+	}
+	for _, P := range parties {
+		P.WaitingFor()
+	}
+
+	logger.mtx.Lock()
+	defer logger.mtx.Unlock()
+	started := make(map[string][]string)
+	finished := make(map[string]int)
+	for _, line := range lines {
+		assert.Equal(t, hex.EncodeToString(sessionID), line["session"], line["msg"])
+		assert.Equal(t, TaskName, line["task"], line["msg"])
+		assert.NotEmpty(t, line["party"], line["msg"])
+		switch line["msg"] {
+		case "round starting", "round started":
+			started[line["party"]] = append(started[line["party"]], line["round"])
+		case "finished!":
+			finished[line["party"]]++
+		}
+	}
+	for _, Pi := range signPIDs {
+		assert.Equal(t, []string{"1", "2", "3", "4"}, started[Pi.String()])
+		assert.Equal(t, 1, finished[Pi.String()])
+	}
+}
+
+func TestE2EStep(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// the parties are stepped in turn on one goroutine, with no channels to block on
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	msg := big.NewInt(200)
+	steppers := make([]*tss.StepParty, len(signPIDs))
+	inboxes := make([][]tss.ParsedMessage, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		params.SetEchoBroadcast()
+		steppers[i], err = tss.NewStepParty(NewLocalParty(msg, params, keys[i], nil, nil))
+		assert.NoError(t, err)
+	}
+	deliver := func(out tss.Message) {
+		bz, _, err := out.WireBytes()
+		assert.NoError(t, err)
+		for j, Pj := range signPIDs {
+			if j == out.GetFrom().Index || (!out.IsBroadcast() && out.GetTo()[0].Index != j) {
+				continue
+			}
+			parsed, err := tss.ParseSessionWireMessage(bz, out.GetFrom(), out.IsBroadcast(), out.WireMsg().GetSessionId())
+			assert.NoError(t, err)
+			inboxes[Pj.Index] = append(inboxes[Pj.Index], parsed)
+		}
+	}
+
+	sigs := make([]*common.SignatureData, len(signPIDs))
+	// step every party until no messages are left in flight
+	for pending := true; pending; {
+		for i, S := range steppers {
+			incoming := inboxes[i]
+			inboxes[i] = nil
+			outgoing, result, err := S.Step(incoming)
+			if !assert.Nil(t, err) {
+				return
+			}
+			for _, out := range outgoing {
+				deliver(out)
+			}
+			if result != nil {
+				sigs[i] = result.(*common.SignatureData)
+			}
+		}
+		pending = false
+		for _, inbox := range inboxes {
+			pending = pending || 0 < len(inbox)
+		}
+	}
+	pk := edwards.PublicKey{Curve: tss.Edwards(), X: keys[0].EDDSAPub.X(), Y: keys[0].EDDSAPub.Y()}
+	for i, sig := range sigs {
+		if !assert.NotNil(t, sig, "party %d must finish", i) {
+			continue
+		}
+		parsed, err := edwards.ParseSignature(sig.Signature)
+		assert.NoError(t, err)
+		assert.True(t, edwards.Verify(&pk, msg.Bytes(), parsed.R, parsed.S), "eddsa verify must pass")
+		assert.Empty(t, steppers[i].Party().WaitingFor())
+	}
+}
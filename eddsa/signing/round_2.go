@@ -9,6 +9,7 @@ package signing
 import (
 	"errors"
 	"math/big"
+	"time"
 
 	errors2 "github.com/pkg/errors"
 
@@ -34,15 +35,17 @@ func (round *round2) Start() *tss.Error {
 
 	// 2. compute Schnorr prove
 	ContextI := append(round.temp.ssid, new(big.Int).SetUint64(uint64(i)).Bytes()...)
-	pir, err := schnorr.NewZKProof(ContextI, round.temp.ri, round.temp.pointRi)
+	start := time.Now()
+	pir, err := schnorr.NewZKProofWithRand(round.Rand(), ContextI, round.temp.ri, round.temp.pointRi)
 	if err != nil {
 		return round.WrapError(errors2.Wrapf(err, "NewZKProof(ri, pointRi)"))
 	}
+	round.ObserveProofGenerated(tss.ProofSchnorr, start)
 
 	// 3. BROADCAST de-commitments of Shamir poly*G and Schnorr prove
 	r2msg2 := NewSignRound2Message(round.PartyID(), round.temp.deCommit, pir)
 	round.temp.signRound2Messages[i] = r2msg2
-	round.out <- r2msg2
+	round.out.Send(r2msg2)
 
 	return nil
 }
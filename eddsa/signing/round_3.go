@@ -9,6 +9,7 @@ package signing
 import (
 	"crypto/sha512"
 	"math/big"
+	"time"
 
 	"github.com/agl/ed25519/edwards25519"
 	"github.com/bnb-chain/tss-lib/v2/common"
@@ -61,7 +62,9 @@ func (round *round3) Start() *tss.Error {
 		if err != nil {
 			return round.WrapError(errors.New("failed to unmarshal Rj proof"), Pj)
 		}
+		start := time.Now()
 		ok = proof.Verify(ContextJ, Rj)
+		round.ObserveProofVerified(tss.ProofSchnorr, ok, start)
 		if !ok {
 			return round.WrapError(errors.New("failed to prove Rj"), Pj)
 		}
@@ -98,7 +101,7 @@ func (round *round3) Start() *tss.Error {
 	// 10. broadcast si to other parties
 	r3msg := NewSignRound3Message(round.PartyID(), encodedBytesToBigInt(&localS))
 	round.temp.signRound3Messages[round.PartyID().Index] = r3msg
-	round.out <- r3msg
+	round.out.Send(r3msg)
 
 	return nil
 }
@@ -26,8 +26,7 @@ type (
 		key     *keygen.LocalPartySaveData
 		data    *common.SignatureData
 		temp    *localTempData
-		out     chan<- tss.Message
-		end     chan<- *common.SignatureData
+		out     *tss.Outbox
 		ok      []bool // `ok` tracks parties which have been verified by Update()
 		started bool
 		number  int
@@ -0,0 +1,84 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+)
+
+const (
+	tagChallenge = "BIP0340/challenge"
+	tagTapTweak  = "TapTweak"
+)
+
+// TaggedHash is the BIP-340 tagged hash SHA256(SHA256(tag) || SHA256(tag) || msgs...)
+func TaggedHash(tag string, msgs ...[]byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, msg := range msgs {
+		h.Write(msg)
+	}
+	return h.Sum(nil)
+}
+
+// XOnlyPubKey returns the 32-byte BIP-340 encoding of a public key, which is the x-coordinate of the point
+func XOnlyPubKey(pub *crypto.ECPoint) []byte {
+	return common.PadToLengthBytesInPlace(pub.X().Bytes(), 32)
+}
+
+// TaprootOutputKey applies the BIP-341 tweak to an internal public key: Q = lift_x(P) + t*G with
+// t = hash_TapTweak(x(P) || merkleRoot). Pass an empty merkleRoot for a key-path-only output (BIP-86).
+// The tweak t is returned alongside Q.
+func TaprootOutputKey(pub *crypto.ECPoint, merkleRoot []byte) (*crypto.ECPoint, *big.Int, error) {
+	ec := pub.Curve()
+	t := new(big.Int).SetBytes(TaggedHash(tagTapTweak, XOnlyPubKey(pub), merkleRoot))
+	if t.Cmp(ec.Params().N) >= 0 {
+		return nil, nil, errors.New("taproot tweak is not less than the curve order")
+	}
+	q, err := liftX(pub).Add(crypto.ScalarBaseMult(ec, t))
+	if err != nil {
+		return nil, nil, err
+	}
+	return q, t, nil
+}
+
+// ----- //
+
+func hasEvenY(p *crypto.ECPoint) bool {
+	return p.Y().Bit(0) == 0
+}
+
+// liftX returns the point with the same x-coordinate as p and an even y-coordinate
+func liftX(p *crypto.ECPoint) *crypto.ECPoint {
+	if hasEvenY(p) {
+		return p
+	}
+	return negatePoint(p)
+}
+
+func negatePoint(p *crypto.ECPoint) *crypto.ECPoint {
+	ec := p.Curve()
+	return crypto.NewECPointNoCurveCheck(ec, p.X(), new(big.Int).Sub(ec.Params().P, p.Y()))
+}
+
+// computeChallenge returns e = int(hash_BIP0340/challenge(x(R) || x(Q) || m)) mod n
+func computeChallenge(bigR, q *crypto.ECPoint, m []byte) *big.Int {
+	e := new(big.Int).SetBytes(TaggedHash(tagChallenge, XOnlyPubKey(bigR), XOnlyPubKey(q), m))
+	return e.Mod(e, q.Curve().Params().N)
+}
+
+// messageBytes encodes a message as the 32 bytes that are signed
+func messageBytes(m *big.Int) []byte {
+	return common.PadToLengthBytesInPlace(m.Bytes(), 32)
+}
@@ -0,0 +1,107 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+var (
+	zero = big.NewInt(0)
+)
+
+func (round *finalization) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 4
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	modN := common.ModInt(ec.Params().N)
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
+	sumS := big.NewInt(0)
+	for j, Pj := range round.Parties().IDs() {
+		round.ok[j] = true
+		r3msg := round.temp.signRound3Messages[j].Content().(*SignRound3Message)
+		sj := r3msg.UnmarshalS()
+		if !round.verifyPartialSignature(j, sj) {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		sumS = modN.Add(sumS, sj)
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(errors.New("partial signature verification failed"), culprits...)
+	}
+	// s = sum(s_j) + e * t
+	s := modN.Add(sumS, modN.Mul(round.temp.e, round.temp.tweak))
+
+	// save the signature for final output
+	rBz := XOnlyPubKey(round.temp.bigR)
+	sBz := common.PadToLengthBytesInPlace(s.Bytes(), 32)
+	round.data.Signature = append(append(make([]byte, 0, 64), rBz...), sBz...)
+	round.data.R = rBz
+	round.data.S = sBz
+	round.data.M = messageBytes(round.temp.m)
+
+	sig, err := schnorr.ParseSignature(round.data.Signature)
+	if err != nil {
+		return round.WrapError(err)
+	}
+	pk, err := schnorr.ParsePubKey(XOnlyPubKey(round.temp.q))
+	if err != nil {
+		return round.WrapError(err)
+	}
+	if ok := sig.Verify(round.data.M, pk); !ok {
+		return round.WrapError(fmt.Errorf("signature verification failed"))
+	}
+	round.out.Finish(round.data)
+
+	return nil
+}
+
+func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *finalization) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *finalization) NextRound() tss.Round {
+	return nil // finished!
+}
+
+// ----- //
+
+// verifyPartialSignature checks s_j * G == K_j + e * W_j, where W_j = lambda_j * X_j and both sides carry the parity
+// adjustments that were applied to the nonce and the key
+func (round *finalization) verifyPartialSignature(j int, sj *big.Int) bool {
+	ec := round.Params().EC()
+	modN := common.ModInt(ec.Params().N)
+	bigKj := round.temp.bigKjs[j]
+	if round.temp.rNeg {
+		bigKj = negatePoint(bigKj)
+	}
+	expected, err := bigKj.Add(round.temp.bigWs[j].ScalarMult(modN.Mul(round.temp.e, round.temp.keyParity)))
+	if err != nil {
+		return false
+	}
+	return crypto.ScalarBaseMult(ec, new(big.Int).Mod(sj, ec.Params().N)).Equals(expected)
+}
@@ -0,0 +1,121 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/test"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+func sign(t *testing.T, msg *big.Int, keys []keygen.LocalPartySaveData, signPIDs tss.SortedPartyIDs, taprootMerkleRoot ...[]byte) []*common.SignatureData {
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan *common.SignatureData, len(signPIDs))
+
+	parties := make([]tss.Party, 0, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		parties = append(parties, NewLocalParty(msg, params, keys[i], outCh, endCh, taprootMerkleRoot...))
+	}
+	for _, P := range parties {
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	sigs := make([]*common.SignatureData, 0, len(signPIDs))
+	for len(sigs) < len(signPIDs) {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			for _, P := range parties {
+				if P.PartyID().Index == msg.GetFrom().Index {
+					continue
+				}
+				go test.SharedPartyUpdater(P, msg, errCh)
+			}
+		case sig := <-endCh:
+			sigs = append(sigs, sig)
+		}
+	}
+	for _, sig := range sigs {
+		assert.Equal(t, sigs[0].Signature, sig.Signature, "all parties must output the same signature")
+	}
+	return sigs
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// PHASE: signing
+	msg := new(big.Int).SetBytes(TaggedHash("test", []byte("message")))
+	sigs := sign(t, msg, keys, signPIDs)
+
+	pk, err := schnorr.ParsePubKey(XOnlyPubKey(keys[0].ECDSAPub))
+	assert.NoError(t, err)
+	sig, err := schnorr.ParseSignature(sigs[0].Signature)
+	assert.NoError(t, err)
+	assert.True(t, sig.Verify(sigs[0].M, pk), "bip-340 verify must pass")
+}
+
+func TestE2EConcurrentTaproot(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	internalKey, err := btcec.ParsePubKey(append([]byte{0x04}, append(
+		common.PadToLengthBytesInPlace(keys[0].ECDSAPub.X().Bytes(), 32),
+		common.PadToLengthBytesInPlace(keys[0].ECDSAPub.Y().Bytes(), 32)...)...))
+	assert.NoError(t, err)
+
+	for _, merkleRoot := range [][]byte{{}, TaggedHash("TapBranch", []byte("scripts"))} {
+		// the output key must match the one derived by btcd
+		q, _, err := TaprootOutputKey(keys[0].ECDSAPub, merkleRoot)
+		assert.NoError(t, err)
+		expected := txscript.ComputeTaprootOutputKey(internalKey, merkleRoot)
+		assert.Equal(t, schnorr.SerializePubKey(expected), XOnlyPubKey(q))
+
+		// PHASE: signing
+		msg := new(big.Int).SetBytes(TaggedHash("TapSighash", []byte("spend")))
+		sigs := sign(t, msg, keys, signPIDs, merkleRoot)
+
+		sig, err := schnorr.ParseSignature(sigs[0].Signature)
+		assert.NoError(t, err)
+		assert.True(t, sig.Verify(sigs[0].M, expected), "bip-340 verify against the taproot output key must pass")
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	ecdsaSigning "github.com/bnb-chain/tss-lib/v2/ecdsa/signing"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+// round 1 represents round 1 of the BIP-340 signing protocol, which follows the round structure of EDDSA signing
+func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *common.SignatureData, temp *localTempData, out *tss.Outbox) tss.Round {
+	return &round1{
+		&base{params, key, data, temp, out, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+
+	// BIP-340 signs 32-byte messages such as a taproot sighash
+	if round.temp.m == nil || round.temp.m.BitLen() > 256 {
+		return round.WrapError(errors.New("message must be at most 32 bytes"))
+	}
+
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	round.temp.ssidNonce = round.SSIDNonce()
+	var err error
+	round.temp.ssid, err = round.getSSID()
+	if err != nil {
+		return round.WrapError(err)
+	}
+	// 1. select ki
+	ki := common.GetRandomPositiveIntWithRand(round.Rand(), round.Params().EC().Params().N)
+
+	// 2. make commitment
+	pointKi := crypto.ScalarBaseMult(round.Params().EC(), ki)
+	cmt := commitments.NewHashCommitmentWithRand(round.Rand(), pointKi.X(), pointKi.Y())
+
+	// 3. store r1 message pieces
+	round.temp.ki = ki
+	round.temp.pointKi = pointKi
+	round.temp.deCommit = cmt.D
+
+	i := round.PartyID().Index
+	round.ok[i] = true
+
+	// 4. broadcast commitment
+	r1msg := NewSignRound1Message(round.PartyID(), cmt.C)
+	round.temp.signRound1Messages[i] = r1msg
+	round.out.Send(r1msg)
+
+	return nil
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.signRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*SignRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
+
+// ----- //
+
+// helper to call into PrepareForSigning() and to set up the BIP-340 (and optionally BIP-341 tweaked) public key
+func (round *round1) prepare() error {
+	i := round.PartyID().Index
+
+	xi := round.key.Xi
+	ks := round.key.Ks
+
+	if round.Threshold()+1 > len(ks) {
+		return fmt.Errorf("t+1=%d is not satisfied by the key count of %d", round.Threshold()+1, len(ks))
+	}
+	round.temp.wi, round.temp.bigWs = ecdsaSigning.PrepareForSigning(round.Params().EC(), i, len(ks), xi, ks, round.key.BigXj)
+
+	// the secret key d = x, negated when P has an odd y-coordinate so that d*G = lift_x(P)
+	modN := common.ModInt(round.Params().EC().Params().N)
+	pub := round.key.ECDSAPub
+	keyParity, tweak := big.NewInt(1), big.NewInt(0)
+	if !hasEvenY(pub) {
+		keyParity = modN.Sub(zero, keyParity)
+	}
+	q := liftX(pub)
+	if round.temp.taproot {
+		var t *big.Int
+		var err error
+		if q, t, err = TaprootOutputKey(pub, round.temp.merkleRoot); err != nil {
+			return err
+		}
+		tweak = t
+	}
+	// d' = d + t, negated again when Q has an odd y-coordinate
+	if !hasEvenY(q) {
+		keyParity = modN.Sub(zero, keyParity)
+		tweak = modN.Sub(zero, tweak)
+	}
+	round.temp.q = q
+	round.temp.keyParity = keyParity
+	round.temp.tweak = tweak
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"math/big"
+
+	errors2 "github.com/pkg/errors"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	modN := common.ModInt(ec.Params().N)
+
+	// 1-5. verify and collect every K_j
+	i := round.PartyID().Index
+	round.temp.bigKjs[i] = round.temp.pointKi
+	for j, Pj := range round.Parties().IDs() {
+		if j == i {
+			continue
+		}
+
+		ContextJ := common.AppendBigIntToBytesSlice(round.temp.ssid, big.NewInt(int64(j)))
+		r2msg := round.temp.signRound2Messages[j].Content().(*SignRound2Message)
+		cmtDeCmt := commitments.HashCommitDecommit{C: round.temp.cjs[j], D: r2msg.UnmarshalDeCommitment()}
+		ok, coordinates := cmtDeCmt.DeCommit()
+		if !ok {
+			return round.WrapError(errors.New("de-commitment verify failed"), Pj)
+		}
+		if len(coordinates) != 2 {
+			return round.WrapError(errors.New("length of de-commitment should be 2"), Pj)
+		}
+		Kj, err := crypto.NewECPoint(ec, coordinates[0], coordinates[1])
+		if err != nil {
+			return round.WrapError(errors2.Wrapf(err, "NewECPoint(Kj)"), Pj)
+		}
+		proof, err := r2msg.UnmarshalZKProof(ec)
+		if err != nil {
+			return round.WrapError(errors.New("failed to unmarshal Kj proof"), Pj)
+		}
+		if ok = proof.Verify(ContextJ, Kj); !ok {
+			return round.WrapError(errors.New("failed to prove Kj"), Pj)
+		}
+		round.temp.bigKjs[j] = Kj
+	}
+
+	// 6. compute R = sum(K_j); the nonce is negated when R has an odd y-coordinate
+	bigR := round.temp.bigKjs[0]
+	for j := 1; j < len(round.temp.bigKjs); j++ {
+		var err error
+		if bigR, err = bigR.Add(round.temp.bigKjs[j]); err != nil {
+			return round.WrapError(errors2.Wrapf(err, "bigR.Add(Kj)"))
+		}
+	}
+	ki := round.temp.ki
+	if !hasEvenY(bigR) {
+		round.temp.rNeg = true
+		ki = modN.Sub(zero, ki)
+	}
+
+	// 7. compute the challenge e = hash_BIP0340/challenge(x(R) || x(Q) || m)
+	e := computeChallenge(bigR, round.temp.q, messageBytes(round.temp.m))
+
+	// 8. compute s_i = k_i + e * d_i
+	si := modN.Add(ki, modN.Mul(e, modN.Mul(round.temp.keyParity, round.temp.wi)))
+
+	// 9. store r3 message pieces
+	round.temp.bigR = bigR
+	round.temp.e = e
+
+	// 10. broadcast si to other parties
+	r3msg := NewSignRound3Message(round.PartyID(), si)
+	round.temp.signRound3Messages[i] = r3msg
+	round.out.Send(r3msg)
+
+	return nil
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.signRound3Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*SignRound3Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round3) NextRound() tss.Round {
+	round.started = false
+	return &finalization{round}
+}
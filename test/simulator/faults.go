@@ -0,0 +1,138 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package simulator
+
+import (
+	"math/rand"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+type (
+	// Fault is applied by the network to every envelope put on it. It returns the envelopes to deliver in its place:
+	// none to drop the message, or more than one to duplicate it.
+	Fault func(env *Envelope, rnd *rand.Rand) []*Envelope
+
+	// Match selects the envelopes that a fault applies to
+	Match func(env *Envelope) bool
+)
+
+// AnyMessage matches every envelope
+func AnyMessage(*Envelope) bool {
+	return true
+}
+
+// From matches the messages sent by the party `pID`
+func From(pID *tss.PartyID) Match {
+	return func(env *Envelope) bool {
+		return env.From.KeyInt().Cmp(pID.KeyInt()) == 0
+	}
+}
+
+// To matches the messages on their way to the party `pID`
+func To(pID *tss.PartyID) Match {
+	return func(env *Envelope) bool {
+		return env.To.KeyInt().Cmp(pID.KeyInt()) == 0
+	}
+}
+
+// OfType matches the messages whose content has the type of `content`, e.g. &signing.SignRound1Message{}
+func OfType(content tss.MessageContent) Match {
+	name := string(proto.MessageName(content))
+	return func(env *Envelope) bool {
+		return env.Msg.Type() == name
+	}
+}
+
+// And matches the envelopes matched by all of `matches`
+func And(matches ...Match) Match {
+	return func(env *Envelope) bool {
+		for _, match := range matches {
+			if !match(env) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Drop loses the matched messages
+func Drop(match Match) Fault {
+	return func(env *Envelope, _ *rand.Rand) []*Envelope {
+		if match(env) {
+			return nil
+		}
+		return []*Envelope{env}
+	}
+}
+
+// Delay holds the matched messages back for `ticks` more ticks
+func Delay(match Match, ticks int) Fault {
+	return func(env *Envelope, _ *rand.Rand) []*Envelope {
+		if match(env) {
+			env.Delay += ticks
+		}
+		return []*Envelope{env}
+	}
+}
+
+// Reorder holds each of the matched messages back for a random number of ticks up to `maxTicks`, so that they may
+// overtake one another and the messages of later rounds
+func Reorder(match Match, maxTicks int) Fault {
+	return func(env *Envelope, rnd *rand.Rand) []*Envelope {
+		if match(env) {
+			env.Delay += rnd.Intn(maxTicks + 1)
+		}
+		return []*Envelope{env}
+	}
+}
+
+// Duplicate delivers `copies` more copies of the matched messages
+func Duplicate(match Match, copies int) Fault {
+	return func(env *Envelope, _ *rand.Rand) []*Envelope {
+		envs := []*Envelope{env}
+		if !match(env) {
+			return envs
+		}
+		for i := 0; i < copies; i++ {
+			dup := *env
+			// each copy is parsed again, so that a later fault may corrupt it alone
+			if msg, err := reparse(env.Msg); err == nil {
+				dup.Msg = msg
+			}
+			envs = append(envs, &dup)
+		}
+		return envs
+	}
+}
+
+// Corrupt lets `mutate` change the content of the matched messages, as a malicious sender or a faulty link would
+func Corrupt(match Match, mutate func(content tss.MessageContent)) Fault {
+	return func(env *Envelope, _ *rand.Rand) []*Envelope {
+		if match(env) {
+			mutate(env.Msg.Content())
+			// keep the wire bytes, which are hashed by echo broadcast and recorded in transcripts, in line
+			if any, err := anypb.New(env.Msg.Content()); err == nil {
+				env.Msg.WireMsg().Message = any
+			}
+		}
+		return []*Envelope{env}
+	}
+}
+
+// ----- //
+
+func reparse(msg tss.ParsedMessage) (tss.ParsedMessage, error) {
+	bz, _, err := msg.WireBytes()
+	if err != nil {
+		return nil, err
+	}
+	return tss.ParseSessionWireMessage(bz, msg.GetFrom(), msg.IsBroadcast(), msg.WireMsg().GetSessionId())
+}
@@ -0,0 +1,358 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package simulator runs the parties of any protocol in one process over a simulated network.
+//
+// The parties are driven with tss.StepParty in a single goroutine, against a virtual clock of ticks: every message
+// takes one tick to arrive, and the messages that arrive in the same tick are handed to the parties in an order drawn
+// from the seed of the Simulator. A run is therefore reproducible, however the network is made to misbehave with the
+// faults given to Inject.
+package simulator
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	// a run which takes longer is reported as an error, as a fault which delays every message again would never end
+	maxTicks = 10000
+)
+
+type (
+	// Simulator routes the messages of its parties as a transport would, by their MessageRouting
+	Simulator struct {
+		rnd    *rand.Rand
+		faults []Fault
+		// the parties of every protocol but resharing are in the new committee
+		oldCommittee, newCommittee committee
+		nodes                      []*Node
+		inFlight                   []*Envelope
+		tick                       int
+	}
+
+	// committee holds the nodes of a committee by the keys of their parties
+	committee map[string]*Node
+
+	// Node is a party of the simulation, and what became of it
+	Node struct {
+		party  *tss.StepParty
+		steps  int
+		result interface{}
+		err    *tss.Error
+		// the node crashes when it has taken crashAfter steps; -1 when it does not crash
+		crashAfter int
+		crashed    bool
+	}
+
+	// Envelope is a message on its way to one of its recipients. Faults may change the message or its delay.
+	Envelope struct {
+		From *tss.PartyID
+		To   *tss.PartyID
+		// parsed from the wire bytes for this recipient only, so that it may be corrupted
+		Msg tss.ParsedMessage
+		// the number of ticks that the message is held back on top of the tick that every message takes
+		Delay int
+		to    *Node
+		due   int
+	}
+)
+
+// New returns a Simulator whose order of delivery and random faults are drawn from `seed`
+func New(seed int64) *Simulator {
+	return &Simulator{
+		rnd:          rand.New(rand.NewSource(seed)),
+		oldCommittee: make(committee),
+		newCommittee: make(committee),
+	}
+}
+
+// Add adds parties which have not been started. The channels given to their constructors are not used and may be
+// nil. In resharing these are the parties of the new committee.
+func (s *Simulator) Add(parties ...tss.Party) error {
+	return s.add(s.newCommittee, parties)
+}
+
+// AddOldCommittee adds the parties of the old committee of resharing, which receive the messages flagged with
+// IsToOldCommittee or IsToOldAndNewCommittees
+func (s *Simulator) AddOldCommittee(parties ...tss.Party) error {
+	return s.add(s.oldCommittee, parties)
+}
+
+// Inject makes the network apply `faults`, in order, to every message that is sent from now on
+func (s *Simulator) Inject(faults ...Fault) {
+	s.faults = append(s.faults, faults...)
+}
+
+// Crash makes the party `pID` stop once it has taken `afterSteps` steps; the first step starts it. The messages it
+// sent before it crashed are still delivered, while those sent to it are lost.
+func (s *Simulator) Crash(pID *tss.PartyID, afterSteps int) error {
+	node := s.Node(pID)
+	if node == nil {
+		return fmt.Errorf("the party %s was not added", pID)
+	}
+	node.crashAfter = afterSteps
+	return nil
+}
+
+// Run starts the parties and delivers their messages until each party has finished, failed or crashed, or until
+// no message is left in flight. It returns nil when every party that did not crash finished. Otherwise it returns
+// the *tss.Error of the first party that failed or, when none did, an error naming the parties which the others are
+// still waiting for.
+func (s *Simulator) Run() error {
+	for _, node := range s.nodes {
+		s.step(node, nil)
+	}
+	for len(s.inFlight) > 0 && !s.done() {
+		if s.tick++; maxTicks < s.tick {
+			return fmt.Errorf("the run did not end in %d ticks", maxTicks)
+		}
+		inboxes := make(map[*Node][]tss.ParsedMessage, len(s.nodes))
+		for _, env := range s.arrivals() {
+			inboxes[env.to] = append(inboxes[env.to], env.Msg)
+		}
+		for _, node := range s.nodes {
+			if msgs, ok := inboxes[node]; ok {
+				s.step(node, msgs)
+			}
+		}
+	}
+	for _, node := range s.nodes {
+		if node.err != nil {
+			return node.err
+		}
+	}
+	if s.done() {
+		return nil
+	}
+	stalled := make([]string, 0, len(s.nodes))
+	for _, node := range s.nodes {
+		if !node.crashed && node.result == nil {
+			stalled = append(stalled, fmt.Sprintf("%s waits for %v", node.PartyID(), node.Party().WaitingFor()))
+		}
+	}
+	return fmt.Errorf("the parties stalled at tick %d: %s", s.tick, strings.Join(stalled, ", "))
+}
+
+// Tick returns the virtual time, in ticks, that the run has taken so far
+func (s *Simulator) Tick() int {
+	return s.tick
+}
+
+// Nodes returns the nodes of the parties in the order in which they were added
+func (s *Simulator) Nodes() []*Node {
+	return s.nodes
+}
+
+// Node returns the node of the party `pID`, or nil when it was not added. A party of both committees of resharing
+// is found in the old one.
+func (s *Simulator) Node(pID *tss.PartyID) *Node {
+	if node, ok := s.oldCommittee[string(pID.Key)]; ok {
+		return node
+	}
+	return s.newCommittee[string(pID.Key)]
+}
+
+// Errors returns the errors of the parties that failed, in the order in which they were added
+func (s *Simulator) Errors() []*tss.Error {
+	errs := make([]*tss.Error, 0, len(s.nodes))
+	for _, node := range s.nodes {
+		if node.err != nil {
+			errs = append(errs, node.err)
+		}
+	}
+	return errs
+}
+
+// Culprits returns the culprits named by the errors of the parties, each once
+func (s *Simulator) Culprits() []*tss.PartyID {
+	seen := make(map[string]bool)
+	culprits := make([]*tss.PartyID, 0, len(s.nodes))
+	for _, err := range s.Errors() {
+		for _, culprit := range err.Culprits() {
+			if culprit != nil && !seen[string(culprit.Key)] {
+				seen[string(culprit.Key)] = true
+				culprits = append(culprits, culprit)
+			}
+		}
+	}
+	return culprits
+}
+
+// ExpectCulprits returns nil when some party failed and the errors of the parties name exactly `culprits`
+func (s *Simulator) ExpectCulprits(culprits ...*tss.PartyID) error {
+	if len(s.Errors()) == 0 {
+		return errors.New("no party failed")
+	}
+	expected := make(map[string]bool, len(culprits))
+	for _, culprit := range culprits {
+		expected[string(culprit.Key)] = true
+	}
+	named := s.Culprits()
+	if len(named) != len(expected) {
+		return fmt.Errorf("expected the culprits %v, the parties named %v", culprits, named)
+	}
+	for _, culprit := range named {
+		if !expected[string(culprit.Key)] {
+			return fmt.Errorf("expected the culprits %v, the parties named %v", culprits, named)
+		}
+	}
+	return nil
+}
+
+// PartyID returns the ID of the party of the node
+func (n *Node) PartyID() *tss.PartyID {
+	return n.party.Party().PartyID()
+}
+
+// Party returns the party of the node, for WaitingFor and the like
+func (n *Node) Party() tss.Party {
+	return n.party.Party()
+}
+
+// Result returns the result of the protocol once the party has finished, or nil
+func (n *Node) Result() interface{} {
+	return n.result
+}
+
+// Err returns the error that the party failed with, or nil
+func (n *Node) Err() *tss.Error {
+	return n.err
+}
+
+// Crashed returns whether the party was crashed by Simulator.Crash
+func (n *Node) Crashed() bool {
+	return n.crashed
+}
+
+// ----- //
+
+func (s *Simulator) add(c committee, parties []tss.Party) error {
+	for _, party := range parties {
+		key := string(party.PartyID().Key)
+		if _, ok := c[key]; ok {
+			return fmt.Errorf("the party %s was added twice", party.PartyID())
+		}
+		stepParty, err := tss.NewStepParty(party)
+		if err != nil {
+			return err
+		}
+		node := &Node{party: stepParty, crashAfter: -1}
+		c[key] = node
+		s.nodes = append(s.nodes, node)
+	}
+	return nil
+}
+
+// done returns whether every node has finished, failed or crashed
+func (s *Simulator) done() bool {
+	for _, node := range s.nodes {
+		if !node.crashed && node.err == nil && node.result == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// step gives `msgs` to a node which is still running, and sends the messages that it sends in turn
+func (s *Simulator) step(node *Node, msgs []tss.ParsedMessage) {
+	if node.crashed || node.err != nil || node.result != nil {
+		return
+	}
+	if node.crashAfter == node.steps {
+		node.crashed = true
+		return
+	}
+	node.steps++
+	outgoing, result, err := node.party.Step(msgs)
+	node.result, node.err = result, err
+	for _, msg := range outgoing {
+		s.send(node, msg)
+	}
+}
+
+// send puts a message on the network, once for each of its recipients, through the faults
+func (s *Simulator) send(from *Node, msg tss.Message) {
+	for _, to := range s.recipients(from, msg) {
+		bz, _, err := msg.WireBytes()
+		if err != nil {
+			from.err = from.Party().WrapError(err)
+			return
+		}
+		parsed, err := tss.ParseSessionWireMessage(bz, msg.GetFrom(), msg.IsBroadcast(), msg.WireMsg().GetSessionId())
+		if err != nil {
+			from.err = from.Party().WrapError(err)
+			return
+		}
+		envs := []*Envelope{{From: msg.GetFrom(), To: to.PartyID(), Msg: parsed, to: to}}
+		for _, fault := range s.faults {
+			var faulted []*Envelope
+			for _, env := range envs {
+				faulted = append(faulted, fault(env, s.rnd)...)
+			}
+			envs = faulted
+		}
+		for _, env := range envs {
+			env.due = s.tick + 1 + env.Delay
+			s.inFlight = append(s.inFlight, env)
+		}
+	}
+}
+
+// recipients returns the nodes that a message is routed to: its committees are chosen by the resharing flags, and
+// within them the parties listed in To, or every party when To is nil
+func (s *Simulator) recipients(from *Node, msg tss.Message) []*Node {
+	var committees []committee
+	switch {
+	case msg.IsToOldAndNewCommittees():
+		committees = []committee{s.oldCommittee, s.newCommittee}
+	case msg.IsToOldCommittee():
+		committees = []committee{s.oldCommittee}
+	default:
+		committees = []committee{s.newCommittee}
+	}
+	seen := make(map[*Node]bool)
+	nodes := make([]*Node, 0, len(s.nodes))
+	addTo := func(node *Node) {
+		if node != nil && node != from && !seen[node] {
+			seen[node] = true
+			nodes = append(nodes, node)
+		}
+	}
+	for _, c := range committees {
+		if msg.GetTo() == nil {
+			for _, node := range s.nodes {
+				if c[string(node.PartyID().Key)] == node {
+					addTo(node)
+				}
+			}
+			continue
+		}
+		for _, pID := range msg.GetTo() {
+			addTo(c[string(pID.Key)])
+		}
+	}
+	return nodes
+}
+
+// arrivals takes the envelopes due by the current tick off the network, in a random order
+func (s *Simulator) arrivals() []*Envelope {
+	var due, later []*Envelope
+	for _, env := range s.inFlight {
+		if env.due <= s.tick {
+			due = append(due, env)
+		} else {
+			later = append(later, env)
+		}
+	}
+	s.inFlight = later
+	s.rnd.Shuffle(len(due), func(i, j int) { due[i], due[j] = due[j], due[i] })
+	return due
+}
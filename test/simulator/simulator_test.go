@@ -0,0 +1,187 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package simulator_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/resharing"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/signing"
+	"github.com/bnb-chain/tss-lib/v2/test"
+	. "github.com/bnb-chain/tss-lib/v2/test/simulator"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+	testSeed         = 42
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+
+	// only for test
+	tss.SetCurve(tss.Edwards())
+}
+
+// newSigning returns a simulation of signing `msg` by the first `count` fixture parties
+func newSigning(t *testing.T, msg *big.Int, count int) (*Simulator, []keygen.LocalPartySaveData, tss.SortedPartyIDs) {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(count)
+	assert.NoError(t, err, "should load keygen fixtures")
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	sim := New(testSeed)
+	for i, pID := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(signPIDs), testThreshold)
+		assert.NoError(t, sim.Add(signing.NewLocalParty(msg, params, keys[i], nil, nil)))
+	}
+	return sim, keys, signPIDs
+}
+
+func verify(t *testing.T, key keygen.LocalPartySaveData, msg *big.Int, result interface{}) {
+	sig, ok := result.(*common.SignatureData)
+	if !assert.True(t, ok, "the result must be a signature") {
+		return
+	}
+	pk := edwards.PublicKey{
+		Curve: tss.Edwards(),
+		X:     key.EDDSAPub.X(),
+		Y:     key.EDDSAPub.Y(),
+	}
+	newSig, err := edwards.ParseSignature(sig.Signature)
+	assert.NoError(t, err)
+	assert.True(t, edwards.Verify(&pk, msg.Bytes(), newSig.R, newSig.S), "eddsa verify must pass")
+}
+
+func TestUnreliableNetwork(t *testing.T) {
+	setUp("info")
+
+	msg := big.NewInt(42)
+	sim, keys, signPIDs := newSigning(t, msg, testThreshold+1)
+	sim.Inject(
+		Reorder(AnyMessage, 5),
+		Duplicate(OfType(&signing.SignRound1Message{}), 2),
+		Delay(From(signPIDs[0]), 10),
+	)
+	assert.NoError(t, sim.Run())
+	assert.Less(t, 10, sim.Tick(), "the delayed messages must hold the run back")
+	for _, node := range sim.Nodes() {
+		verify(t, keys[0], msg, node.Result())
+	}
+}
+
+func TestCorruption(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	sim := New(testSeed)
+	for _, pID := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), testThreshold)
+		assert.NoError(t, sim.Add(keygen.NewLocalParty(params, nil, nil)))
+	}
+	// party 1 sends a Schnorr proof that does not verify
+	sim.Inject(Corrupt(And(From(pIDs[1]), OfType(&keygen.KGRound2Message2{})), func(content tss.MessageContent) {
+		r2msg2 := content.(*keygen.KGRound2Message2)
+		r2msg2.ProofT = new(big.Int).Add(new(big.Int).SetBytes(r2msg2.ProofT), big.NewInt(1)).Bytes()
+	}))
+
+	err := sim.Run()
+	assert.Error(t, err)
+	assert.NoError(t, sim.ExpectCulprits(pIDs[1]))
+	assert.Error(t, sim.ExpectCulprits(pIDs[2]))
+	for _, node := range sim.Nodes() {
+		if node.PartyID() == pIDs[1] {
+			assert.Nil(t, node.Err(), "the culprit does not check its own proof")
+		} else {
+			assert.NotNil(t, node.Err())
+		}
+	}
+}
+
+func TestStall(t *testing.T) {
+	setUp("info")
+
+	msg := big.NewInt(42)
+	tests := map[string]func(sim *Simulator, culprit *tss.PartyID){
+		"drop": func(sim *Simulator, culprit *tss.PartyID) {
+			sim.Inject(Drop(And(From(culprit), OfType(&signing.SignRound2Message{}))))
+		},
+		"crash": func(sim *Simulator, culprit *tss.PartyID) {
+			// the party crashes once it has started and sent its first message
+			assert.NoError(t, sim.Crash(culprit, 1))
+		},
+	}
+	for name, fault := range tests {
+		sim, _, signPIDs := newSigning(t, msg, testThreshold+1)
+		culprit := signPIDs[1]
+		fault(sim, culprit)
+
+		assert.Error(t, sim.Run(), name)
+		assert.Empty(t, sim.Errors(), name)
+		for _, node := range sim.Nodes() {
+			assert.Nil(t, node.Result(), name)
+			if node.PartyID() == culprit {
+				continue
+			}
+			assert.Contains(t, node.Party().WaitingFor(), culprit, name)
+		}
+		assert.Equal(t, name == "crash", sim.Node(culprit).Crashed(), name)
+	}
+}
+
+func TestResharing(t *testing.T) {
+	setUp("info")
+
+	oldKeys, oldPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 2)
+	assert.NoError(t, err, "should load keygen fixtures")
+	oldP2PCtx := tss.NewPeerContext(oldPIDs)
+	newPIDs := tss.GenerateTestPartyIDs(testParticipants)
+	newP2PCtx := tss.NewPeerContext(newPIDs)
+
+	sim := New(testSeed)
+	for i, pID := range oldPIDs {
+		params := tss.NewReSharingParameters(tss.Edwards(), oldP2PCtx, newP2PCtx, pID, testParticipants, testThreshold, len(newPIDs), testThreshold)
+		assert.NoError(t, sim.AddOldCommittee(resharing.NewLocalParty(params, oldKeys[i], nil, nil)))
+	}
+	for _, pID := range newPIDs {
+		params := tss.NewReSharingParameters(tss.Edwards(), oldP2PCtx, newP2PCtx, pID, testParticipants, testThreshold, len(newPIDs), testThreshold)
+		assert.NoError(t, sim.Add(resharing.NewLocalParty(params, keygen.NewLocalPartySaveData(len(newPIDs)), nil, nil)))
+	}
+	sim.Inject(Reorder(AnyMessage, 3))
+	assert.NoError(t, sim.Run())
+
+	newKeys := make([]keygen.LocalPartySaveData, 0, len(newPIDs))
+	for _, pID := range newPIDs {
+		key := sim.Node(pID).Result().(*keygen.LocalPartySaveData)
+		assert.True(t, oldKeys[0].EDDSAPub.Equals(key.EDDSAPub), "the public key must not change")
+		newKeys = append(newKeys, *key)
+	}
+	for _, pID := range oldPIDs {
+		assert.Nil(t, sim.Node(pID).Result().(*keygen.LocalPartySaveData).Xi, "the old committee gives up its shares")
+	}
+
+	// the new committee signs
+	msg := big.NewInt(42)
+	signP2PCtx := tss.NewPeerContext(newPIDs)
+	sim = New(testSeed)
+	for i, pID := range newPIDs {
+		params := tss.NewParameters(tss.Edwards(), signP2PCtx, pID, len(newPIDs), testThreshold)
+		assert.NoError(t, sim.Add(signing.NewLocalParty(msg, params, newKeys[i], nil, nil)))
+	}
+	assert.NoError(t, sim.Run())
+	verify(t, newKeys[0], msg, sim.Nodes()[0].Result())
+}
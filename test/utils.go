@@ -7,6 +7,8 @@
 package test
 
 import (
+	"sync"
+
 	"github.com/bnb-chain/tss-lib/v2/tss"
 )
 
@@ -20,7 +22,7 @@ func SharedPartyUpdater(party tss.Party, msg tss.Message, errCh chan<- *tss.Erro
 		errCh <- party.WrapError(err)
 		return
 	}
-	pMsg, err := tss.ParseWireMessage(bz, msg.GetFrom(), msg.IsBroadcast())
+	pMsg, err := tss.ParseSessionWireMessage(bz, msg.GetFrom(), msg.IsBroadcast(), msg.WireMsg().GetSessionId())
 	if err != nil {
 		errCh <- party.WrapError(err)
 		return
@@ -29,3 +31,20 @@ func SharedPartyUpdater(party tss.Party, msg tss.Message, errCh chan<- *tss.Erro
 		errCh <- err
 	}
 }
+
+// CheckpointEpochs is an in-memory tss.CheckpointEpochs for tests. Epoch may be set to simulate the state of the
+// store when a party crashed.
+type CheckpointEpochs struct {
+	mtx   sync.Mutex
+	Epoch uint64
+}
+
+func (e *CheckpointEpochs) CompareAndSwap(old, new uint64) (bool, error) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	if e.Epoch != old {
+		return false, nil
+	}
+	e.Epoch = new
+	return true, nil
+}
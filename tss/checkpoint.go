@@ -0,0 +1,419 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Checkpoints
+//
+// A party given a CheckpointFunc hands its state to the function once it has started each round after the first,
+// and does not send the messages of the round unless the function succeeds. The state includes what the round drew
+// at random and the messages it sent, so a party resumed from the checkpoint does not start the round again: it
+// sends the same messages once more, and never uses its secret nonces with different inputs or gives a peer two
+// different messages for the round.
+//
+// Resuming from an older checkpoint, or from the same checkpoint twice, would use the nonces again with other
+// messages of the peers. The checkpoints of a party are therefore numbered by an epoch kept in CheckpointEpochs: it
+// is raised before each checkpoint is saved and when a party is resumed, and a checkpoint is only resumed from while
+// its epoch is the latest. No checkpoint is taken in the first round, so a party that stops before its first
+// checkpoint cannot be resumed and the session must be started over with a new session ID.
+
+type (
+	// CheckpointFunc saves the state of a party once it has started the round at index `step`, counting from zero.
+	// It is called while the party is locked.
+	CheckpointFunc func(step int, state []byte) error
+
+	// CheckpointEpochs holds the epoch of the latest checkpoint of one party in one session, which is zero before
+	// the first checkpoint. It must be kept in durable storage with the checkpoints.
+	CheckpointEpochs interface {
+		// CompareAndSwap atomically sets the epoch to `new` if it is `old`, and reports whether it did
+		CompareAndSwap(old, new uint64) (bool, error)
+	}
+
+	// CheckpointRound is implemented by the rounds of a protocol that supports checkpoints. A resumed round is
+	// restored from the state it had when the checkpoint was taken, rather than started again.
+	CheckpointRound interface {
+		Round
+		// CheckpointState returns the round number and the `ok` trackers of a started round
+		CheckpointState() (number int, ok [][]bool)
+		// RestoreState marks the round as started with the state returned by CheckpointState
+		RestoreState(number int, ok [][]bool) error
+	}
+
+	checkpointState struct {
+		step    int
+		epoch   uint64
+		parties []*PartyID
+		epochs  CheckpointEpochs
+		save    CheckpointFunc
+		resume  *partyCheckpoint
+	}
+
+	partyCheckpoint struct {
+		Step       int
+		Epoch      uint64
+		PartyKey   []byte
+		SessionID  []byte
+		PartyKeys  [][]byte
+		Accepted   [][]byte
+		Echoes     [][]byte
+		EchoesSent []int
+		// the state of the round, and the messages it sent that had not been passed on yet
+		RoundNumber int
+		RoundOK     [][]bool
+		Sent        [][]byte
+	}
+)
+
+// SetCheckpointFunc makes the party call `save` once it has started each round after the first. `parties` are all the
+// parties that may send messages to this one, including both committees in re-sharing. The party raises the epoch in
+// `epochs` before each call, and stops if it is not the one the party expects.
+func SetCheckpointFunc(p Party, parties []*PartyID, epochs CheckpointEpochs, save CheckpointFunc) {
+	p.lock()
+	defer p.unlock()
+	cp := p.checkpoints()
+	cp.parties, cp.epochs, cp.save = parties, epochs, save
+}
+
+// ResumeParty loads the state given to a CheckpointFunc into a new party, which continues from that point in Start
+// and sends the messages of the round again.
+// The protocol specific state must be restored by the caller. `parties` and `epochs` are as given to
+// SetCheckpointFunc. Start fails unless the checkpoint is the latest of the party and has not been resumed from yet.
+func ResumeParty(p Party, parties []*PartyID, epochs CheckpointEpochs, state []byte) error {
+	pc := new(partyCheckpoint)
+	if err := json.Unmarshal(state, pc); err != nil {
+		return err
+	}
+	if pc.Step < 1 || pc.Epoch < 1 {
+		return errors.New("the checkpoint has an invalid step or epoch")
+	}
+	if epochs == nil {
+		return errors.New("checkpoint epochs are required to resume a party")
+	}
+	p.lock()
+	defer p.unlock()
+	if p.round() != nil {
+		return errors.New("a party can only be resumed before it is started")
+	}
+	cp := p.checkpoints()
+	cp.parties, cp.epochs, cp.resume = parties, epochs, pc
+	return nil
+}
+
+// ----- //
+
+func (p *BaseParty) checkpoints() *checkpointState {
+	return &p.cp
+}
+
+// saveCheckpoint is called with the party locked, after it has started a round and before the messages that the
+// round sent are passed on
+func saveCheckpoint(p Party) *Error {
+	cp := p.checkpoints()
+	if cp.save == nil {
+		return nil
+	}
+	sent, finished := p.output().box.queued()
+	if finished {
+		// there is nothing left to resume
+		return nil
+	}
+	round, ok := p.round().(CheckpointRound)
+	if !ok {
+		return p.WrapError(errors.New("the round does not support checkpoints"))
+	}
+	// the epoch is raised before the checkpoint is saved, so that no older checkpoint can be resumed from once it is
+	epoch := cp.epoch + 1
+	if err := swapEpoch(cp.epochs, cp.epoch, epoch); err != nil {
+		return p.WrapError(fmt.Errorf("failed to save a checkpoint: %w", err))
+	}
+	cp.epoch = epoch
+	params := round.Params()
+	pc := &partyCheckpoint{
+		Step:      cp.step,
+		Epoch:     epoch,
+		PartyKey:  params.PartyID().GetKey(),
+		SessionID: params.SessionID(),
+		PartyKeys: partyKeys(cp.parties),
+	}
+	pc.RoundNumber, pc.RoundOK = round.CheckpointState()
+	var err error
+	if pc.Accepted, err = p.checkpointMessages(); err != nil {
+		return p.WrapError(err)
+	}
+	if pc.Echoes, pc.EchoesSent, err = p.checkpointEchoes(); err != nil {
+		return p.WrapError(err)
+	}
+	if pc.Sent, err = marshalSent(sent); err != nil {
+		return p.WrapError(err)
+	}
+	state, err := json.Marshal(pc)
+	if err != nil {
+		return p.WrapError(err)
+	}
+	if err = cp.save(cp.step, state); err != nil {
+		return p.WrapError(fmt.Errorf("failed to save a checkpoint: %w", err))
+	}
+	return nil
+}
+
+// resumeRound is called with the party locked in place of FirstRound; it returns the restored round, which has been
+// started, and the messages to send again
+func resumeRound(p Party) (Round, []Message, *Error) {
+	cp := p.checkpoints()
+	pc := cp.resume
+	cp.resume = nil
+	round := p.FirstRound()
+	params := round.Params()
+	if !bytes.Equal(pc.PartyKey, params.PartyID().GetKey()) {
+		return nil, nil, p.WrapError(errors.New("the checkpoint belongs to another party"))
+	}
+	if !bytes.Equal(pc.SessionID, params.SessionID()) {
+		return nil, nil, p.WrapError(errors.New("the checkpoint belongs to another session"))
+	}
+	keys := partyKeys(cp.parties)
+	if len(keys) != len(pc.PartyKeys) {
+		return nil, nil, p.WrapError(errors.New("the checkpoint was taken with other parties"))
+	}
+	for i := range keys {
+		if !bytes.Equal(keys[i], pc.PartyKeys[i]) {
+			return nil, nil, p.WrapError(errors.New("the checkpoint was taken with other parties"))
+		}
+	}
+	for i := 0; i < pc.Step; i++ {
+		if round = round.NextRound(); round == nil {
+			return nil, nil, p.WrapError(fmt.Errorf("the checkpoint is past the last round of the protocol (step %d)", pc.Step))
+		}
+	}
+	for _, bz := range pc.Accepted {
+		msg, err := UnmarshalMessage(bz, cp.parties)
+		if err != nil {
+			return nil, nil, p.WrapError(err)
+		}
+		p.recordMessage(msg)
+	}
+	if err := p.restoreEchoes(pc.Echoes, pc.EchoesSent, cp.parties); err != nil {
+		return nil, nil, p.WrapError(err)
+	}
+	restored, ok := round.(CheckpointRound)
+	if !ok {
+		return nil, nil, p.WrapError(errors.New("the round does not support checkpoints"))
+	}
+	if err := restored.RestoreState(pc.RoundNumber, pc.RoundOK); err != nil {
+		return nil, nil, p.WrapError(err)
+	}
+	sent, err := unmarshalSent(pc.Sent, cp.parties)
+	if err != nil {
+		return nil, nil, p.WrapError(err)
+	}
+	// the checkpoint is used up, so that it cannot be resumed from again
+	if err := swapEpoch(cp.epochs, pc.Epoch, pc.Epoch+1); err != nil {
+		return nil, nil, p.WrapError(fmt.Errorf("the checkpoint cannot be resumed from: %w", err))
+	}
+	cp.step, cp.epoch = pc.Step, pc.Epoch+1
+	return round, sent, nil
+}
+
+// swapEpoch moves the epoch of the party's checkpoints from `old` to `new`
+func swapEpoch(epochs CheckpointEpochs, old, new uint64) error {
+	if epochs == nil {
+		return errors.New("no checkpoint epochs are set")
+	}
+	swapped, err := epochs.CompareAndSwap(old, new)
+	if err != nil {
+		return err
+	}
+	if !swapped {
+		return fmt.Errorf("the checkpoint epoch is no longer %d, as another instance of the party has saved or resumed "+
+			"from a checkpoint", old)
+	}
+	return nil
+}
+
+func (p *BaseParty) checkpointMessages() ([][]byte, error) {
+	msgs := make([]ParsedMessage, 0, len(p.accepted))
+	for _, msg := range p.accepted {
+		msgs = append(msgs, msg)
+	}
+	return MarshalMessages(msgs)
+}
+
+func (p *BaseParty) checkpointEchoes() ([][]byte, []int, error) {
+	var echoes [][]byte
+	for _, received := range p.echo.received {
+		for _, msg := range received {
+			bz, err := MarshalMessage(msg)
+			if err != nil {
+				return nil, nil, err
+			}
+			echoes = append(echoes, bz)
+		}
+	}
+	sent := make([]int, 0, len(p.echo.sent))
+	for number := range p.echo.sent {
+		sent = append(sent, number)
+	}
+	return echoes, sent, nil
+}
+
+func (p *BaseParty) restoreEchoes(echoes [][]byte, sent []int, parties []*PartyID) error {
+	for _, bz := range echoes {
+		msg, err := UnmarshalMessage(bz, parties)
+		if err != nil {
+			return err
+		}
+		if err := p.storeEcho(msg); err != nil {
+			return err
+		}
+	}
+	for _, number := range sent {
+		if p.echo.sent == nil {
+			p.echo.sent = make(map[int]bool)
+		}
+		p.echo.sent[number] = true
+	}
+	return nil
+}
+
+// marshalSent encodes the messages a party has sent, as stamped, so that they can be sent again unchanged
+func marshalSent(msgs []Message) ([][]byte, error) {
+	bzs := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		if bzs[i], err = proto.Marshal(msg.WireMsg()); err != nil {
+			return nil, err
+		}
+	}
+	return bzs, nil
+}
+
+func unmarshalSent(bzs [][]byte, parties []*PartyID) ([]Message, error) {
+	msgs := make([]Message, len(bzs))
+	for i, bz := range bzs {
+		wire := new(MessageWrapper)
+		if err := proto.Unmarshal(bz, wire); err != nil {
+			return nil, err
+		}
+		if wire.GetMessage() == nil {
+			return nil, errors.New("the checkpoint holds a sent message without content")
+		}
+		m, err := wire.Message.UnmarshalNew()
+		if err != nil {
+			return nil, err
+		}
+		content, ok := m.(MessageContent)
+		if !ok {
+			return nil, errors.New("the checkpoint holds a sent message with unknown content")
+		}
+		meta := MessageRouting{
+			IsBroadcast:             wire.IsBroadcast,
+			IsToOldCommittee:        wire.IsToOldCommittee,
+			IsToOldAndNewCommittees: wire.IsToOldAndNewCommittees,
+		}
+		if meta.From = findParty(parties, wire.GetFrom().GetKey()); meta.From == nil {
+			return nil, fmt.Errorf("the checkpoint holds a message sent by an unknown party %s", wire.GetFrom().GetId())
+		}
+		for _, id := range wire.GetTo() {
+			Pj := findParty(parties, id.GetKey())
+			if Pj == nil {
+				return nil, fmt.Errorf("the checkpoint holds a message sent to an unknown party %s", id.GetId())
+			}
+			meta.To = append(meta.To, Pj)
+		}
+		msgs[i] = NewMessage(meta, content, wire)
+	}
+	return msgs, nil
+}
+
+func findParty(parties []*PartyID, key []byte) *PartyID {
+	for _, Pj := range parties {
+		if bytes.Equal(Pj.GetKey(), key) {
+			return Pj
+		}
+	}
+	return nil
+}
+
+func partyKeys(parties []*PartyID) [][]byte {
+	keys := make([][]byte, len(parties))
+	for i, Pj := range parties {
+		keys[i] = Pj.GetKey()
+	}
+	return keys
+}
+
+// ----- //
+
+// MarshalMessage encodes a message held by a party for a checkpoint. A nil message is encoded as nil.
+func MarshalMessage(msg ParsedMessage) ([]byte, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	// the wire message may have been sealed for the secure channel, so the content is wrapped again
+	any, err := anypb.New(msg.Content())
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(&MessageWrapper{
+		IsBroadcast:             msg.IsBroadcast(),
+		IsToOldCommittee:        msg.IsToOldCommittee(),
+		IsToOldAndNewCommittees: msg.IsToOldAndNewCommittees(),
+		From:                    msg.GetFrom().MessageWrapper_PartyID,
+		Message:                 any,
+		SessionId:               msg.WireMsg().GetSessionId(),
+	})
+}
+
+// UnmarshalMessage decodes a message encoded by MarshalMessage, which must be from one of `parties`
+func UnmarshalMessage(bz []byte, parties []*PartyID) (ParsedMessage, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	wire := new(MessageWrapper)
+	if err := proto.Unmarshal(bz, wire); err != nil {
+		return nil, err
+	}
+	if wire.GetMessage() == nil {
+		return nil, errors.New("the checkpoint holds a message without content")
+	}
+	for _, Pj := range parties {
+		if bytes.Equal(Pj.GetKey(), wire.GetFrom().GetKey()) {
+			return parseWrappedMessage(wire, Pj)
+		}
+	}
+	return nil, fmt.Errorf("the checkpoint holds a message from an unknown party %s", wire.GetFrom().GetId())
+}
+
+func MarshalMessages(msgs []ParsedMessage) ([][]byte, error) {
+	bzs := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		if bzs[i], err = MarshalMessage(msg); err != nil {
+			return nil, err
+		}
+	}
+	return bzs, nil
+}
+
+func UnmarshalMessages(bzs [][]byte, parties []*PartyID) ([]ParsedMessage, error) {
+	msgs := make([]ParsedMessage, len(bzs))
+	for i, bz := range bzs {
+		var err error
+		if msgs[i], err = UnmarshalMessage(bz, parties); err != nil {
+			return nil, err
+		}
+	}
+	return msgs, nil
+}
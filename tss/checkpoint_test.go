@@ -0,0 +1,110 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto/sealed"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/signing"
+	"github.com/bnb-chain/tss-lib/v2/test"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func TestCheckpointResendsRound(t *testing.T) {
+	kek, err := sealed.NewAESKEK("test", make([]byte, 32))
+	assert.NoError(t, err)
+	steppers, keys, signPIDs := newSigners(t, nil)
+	var saved []byte
+	epochs := new(test.CheckpointEpochs)
+	steppers[0].Party().(*signing.LocalParty).EnableCheckpoints(kek, epochs, func(checkpoint []byte) error {
+		if md, _ := sealed.ReadMetadata(checkpoint); md.Epoch == 1 {
+			saved = checkpoint
+		}
+		return nil
+	})
+
+	// the transport keeps what party 0 sent in round 2 and what it received, to compare and deliver them again
+	var sent [][]byte
+	var toParty0 []tss.ParsedMessage
+	results, tssErr := runSteps(t, steppers, func(msg tss.ParsedMessage, to *tss.PartyID) tss.ParsedMessage {
+		if _, ok := msg.Content().(*signing.SignRound2Message); ok && msg.GetFrom() == signPIDs[0] && to == signPIDs[1] {
+			bz, _, err := msg.WireBytes()
+			assert.NoError(t, err)
+			sent = append(sent, bz)
+		}
+		if to == signPIDs[0] {
+			toParty0 = append(toParty0, msg)
+		}
+		return msg
+	})
+	assert.Nil(t, tssErr)
+	for _, result := range results {
+		verifySignature(t, keys[0], result)
+	}
+	if !assert.NotNil(t, saved, "party 0 should have saved a checkpoint in round 2") {
+		return
+	}
+
+	resume := func() (*tss.StepParty, []tss.Message, *tss.Error) {
+		params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(signPIDs), signPIDs[0], len(signPIDs), testThreshold)
+		P, err := signing.ResumeLocalParty(saved, kek, epochs, testMsg, params, keys[0], nil, nil)
+		assert.NoError(t, err)
+		S, err := tss.NewStepParty(P)
+		assert.NoError(t, err)
+		outgoing, _, tssErr := S.Step(nil)
+		return S, outgoing, tssErr
+	}
+
+	// the party went on to take later checkpoints, so the one of round 2 is stale
+	_, _, tssErr = resume()
+	assert.NotNil(t, tssErr, "an older checkpoint must not be resumed from")
+
+	// party 0 crashed after the checkpoint; once resumed it sends the same round 2 messages, not new ones
+	epochs.Epoch = 1
+	S, outgoing, tssErr := resume()
+	assert.Nil(t, tssErr)
+	var resent [][]byte
+	for _, out := range outgoing {
+		if _, ok := out.(tss.ParsedMessage).Content().(*signing.SignRound2Message); ok {
+			bz, _, err := out.WireBytes()
+			assert.NoError(t, err)
+			resent = append(resent, bz)
+		}
+	}
+	assert.NotEmpty(t, sent)
+	assert.Equal(t, sent, resent, "a resumed party must send what it sent before the crash")
+
+	_, result, tssErr := S.Step(toParty0)
+	assert.Nil(t, tssErr)
+	verifySignature(t, keys[0], result)
+
+	_, _, tssErr = resume()
+	assert.NotNil(t, tssErr, "a checkpoint must not be resumed from twice")
+}
+
+func TestCheckpointFailureDropsRound(t *testing.T) {
+	kek, err := sealed.NewAESKEK("test", make([]byte, 32))
+	assert.NoError(t, err)
+	steppers, _, signPIDs := newSigners(t, nil)
+	errCrash := errors.New("crashed")
+	steppers[0].Party().(*signing.LocalParty).EnableCheckpoints(kek, new(test.CheckpointEpochs), func(checkpoint []byte) error {
+		return errCrash
+	})
+	_, tssErr := runSteps(t, steppers, func(msg tss.ParsedMessage, _ *tss.PartyID) tss.ParsedMessage {
+		_, ok := msg.Content().(*signing.SignRound2Message)
+		assert.False(t, ok && msg.GetFrom() == signPIDs[0], "a round must not be sent without its checkpoint")
+		return msg
+	})
+	if assert.NotNil(t, tssErr) {
+		assert.True(t, errors.Is(tssErr, errCrash))
+		assert.Equal(t, signPIDs[0], tssErr.Victim())
+	}
+}
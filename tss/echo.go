@@ -0,0 +1,208 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+)
+
+// Echo broadcast
+//
+// When enabled with Parameters.SetEchoBroadcast, a party that has received every message of a round sends an
+// EchoBroadcastMessage to its peers before moving on. The echo lists a digest of each broadcast message the party
+// has accepted, and the party only proceeds once the echoes of all peers agree with the broadcasts it received.
+// A sender that gave different parties different broadcasts is caught before any of them acts on the values.
+// The echoes are not signed, so a mismatch does not show whether the sender or the echoing peer lied, and the
+// error names neither of them.
+
+type echoState struct {
+	// the rounds for which this party has sent its echo
+	sent map[int]bool
+	// the echoes received from the peers, by round number and sender key
+	received map[int]map[string]ParsedMessage
+}
+
+func NewEchoBroadcastMessage(from *PartyID, to []*PartyID, round int, entries []*EchoBroadcastMessage_Entry) ParsedMessage {
+	meta := MessageRouting{
+		From:                    from,
+		To:                      to,
+		IsBroadcast:             true,
+		IsToOldAndNewCommittees: to != nil,
+	}
+	content := &EchoBroadcastMessage{
+		Round:   int32(round),
+		Entries: entries,
+	}
+	msg := NewMessageWrapper(meta, content)
+	return NewMessage(meta, content, msg)
+}
+
+func (m *EchoBroadcastMessage) ValidateBasic() bool {
+	if m == nil || m.GetRound() < 1 {
+		return false
+	}
+	for _, entry := range m.GetEntries() {
+		if entry == nil || len(entry.GetSender()) == 0 || entry.GetType() == "" || len(entry.GetDigest()) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func isEchoBroadcast(msg ParsedMessage) bool {
+	if msg == nil {
+		return false
+	}
+	_, ok := msg.Content().(*EchoBroadcastMessage)
+	return ok
+}
+
+func echoDigest(msg ParsedMessage) ([]byte, error) {
+	bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Content())
+	if err != nil {
+		return nil, err
+	}
+	return common.SHA512_256([]byte(msg.Type()), bz), nil
+}
+
+// echoPeers returns the parties which exchange echoes with this party, without itself
+func (params *Parameters) echoPeers() []*PartyID {
+	ids := params.echoTo
+	if ids == nil {
+		ids = params.Parties().IDs()
+	}
+	seen := make(map[string]bool, len(ids))
+	seen[string(params.PartyID().GetKey())] = true
+	peers := make([]*PartyID, 0, len(ids))
+	for _, Pj := range ids {
+		if key := string(Pj.GetKey()); !seen[key] {
+			seen[key] = true
+			peers = append(peers, Pj)
+		}
+	}
+	return peers
+}
+
+// ----- //
+
+func validateEcho(p Party, msg ParsedMessage) *Error {
+	if msg.GetFrom() == nil || !msg.GetFrom().ValidateBasic() {
+		return p.WrapError(fmt.Errorf("received msg with an invalid sender: %s", msg))
+	}
+	if !msg.ValidateBasic() {
+		return p.WrapError(fmt.Errorf("message failed ValidateBasic: %s", msg), msg.GetFrom())
+	}
+	return nil
+}
+
+func (p *BaseParty) storeEcho(msg ParsedMessage) *Error {
+	number := int(msg.Content().(*EchoBroadcastMessage).GetRound())
+	if p.echo.received == nil {
+		p.echo.received = make(map[int]map[string]ParsedMessage)
+	}
+	if p.echo.received[number] == nil {
+		p.echo.received[number] = make(map[string]ParsedMessage)
+	}
+	key := string(msg.GetFrom().GetKey())
+	if prev, ok := p.echo.received[number][key]; ok && !proto.Equal(prev.Content(), msg.Content()) {
+		return p.WrapError(fmt.Errorf("equivocation: received a conflicting echo for round %d from party %s", number, msg.GetFrom()), msg.GetFrom())
+	}
+	p.echo.received[number][key] = msg
+	return nil
+}
+
+// newEcho lists a digest of every broadcast message accepted by the party so far
+func (p *BaseParty) newEcho(params *Parameters, number int) (ParsedMessage, error) {
+	keys := make([]string, 0, len(p.accepted))
+	for key, msg := range p.accepted {
+		if msg.IsBroadcast() {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	entries := make([]*EchoBroadcastMessage_Entry, len(keys))
+	for i, key := range keys {
+		msg := p.accepted[key]
+		digest, err := echoDigest(msg)
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = &EchoBroadcastMessage_Entry{
+			Sender: msg.GetFrom().GetKey(),
+			Type:   slotType(msg),
+			Digest: digest,
+		}
+	}
+	return NewEchoBroadcastMessage(params.PartyID(), params.echoTo, number, entries), nil
+}
+
+func (p *BaseParty) missingEchoes(round Round) []*PartyID {
+	params := round.Params()
+	if !params.EchoBroadcast() {
+		return nil
+	}
+	received := p.echo.received[round.RoundNumber()]
+	var missing []*PartyID
+	for _, Pj := range params.echoPeers() {
+		if _, ok := received[string(Pj.GetKey())]; !ok {
+			missing = append(missing, Pj)
+		}
+	}
+	return missing
+}
+
+// echoReady is called once the current round can proceed. It sends the party's echo for the round and
+// reports whether the echoes of all peers have arrived and agree with the broadcasts this party received.
+func (p *BaseParty) echoReady(round Round) (bool, *Error) {
+	params := round.Params()
+	if !params.EchoBroadcast() {
+		return true, nil
+	}
+	number := round.RoundNumber()
+	if !p.echo.sent[number] {
+		msg, err := p.newEcho(params, number)
+		if err != nil {
+			return false, round.WrapError(err)
+		}
+		if p.echo.sent == nil {
+			p.echo.sent = make(map[int]bool)
+		}
+		p.echo.sent[number] = true
+		p.out.box.Send(msg)
+	}
+	if 0 < len(p.missingEchoes(round)) {
+		return false, nil
+	}
+	for _, Pj := range params.echoPeers() {
+		echo := p.echo.received[number][string(Pj.GetKey())]
+		if err := params.ValidateSessionID(echo); err != nil {
+			return false, round.WrapError(err, Pj)
+		}
+		for _, entry := range echo.Content().(*EchoBroadcastMessage).GetEntries() {
+			msg, ok := p.accepted[messageSlot(entry.GetSender(), entry.GetType())]
+			if !ok || !msg.IsBroadcast() {
+				// not received by this party yet; a later echo will list it again
+				continue
+			}
+			digest, err := echoDigest(msg)
+			if err != nil {
+				return false, round.WrapError(err)
+			}
+			if !bytes.Equal(digest, entry.GetDigest()) {
+				return false, round.WrapError(fmt.Errorf("echo broadcast: party %s reports a different %s from party %s",
+					Pj, msg.Type(), msg.GetFrom()))
+			}
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,245 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.20.3
+// source: protob/echo.proto
+
+package tss
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Sent by every party once it has received the messages of a round, when echo broadcast is enabled.
+// Lists a digest of every broadcast message the party has accepted so far.
+type EchoBroadcastMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round   int32                         `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Entries []*EchoBroadcastMessage_Entry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *EchoBroadcastMessage) Reset() {
+	*x = EchoBroadcastMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_echo_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EchoBroadcastMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EchoBroadcastMessage) ProtoMessage() {}
+
+func (x *EchoBroadcastMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_echo_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EchoBroadcastMessage.ProtoReflect.Descriptor instead.
+func (*EchoBroadcastMessage) Descriptor() ([]byte, []int) {
+	return file_protob_echo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EchoBroadcastMessage) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *EchoBroadcastMessage) GetEntries() []*EchoBroadcastMessage_Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type EchoBroadcastMessage_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender []byte `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Digest []byte `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (x *EchoBroadcastMessage_Entry) Reset() {
+	*x = EchoBroadcastMessage_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_echo_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EchoBroadcastMessage_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EchoBroadcastMessage_Entry) ProtoMessage() {}
+
+func (x *EchoBroadcastMessage_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_echo_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EchoBroadcastMessage_Entry.ProtoReflect.Descriptor instead.
+func (*EchoBroadcastMessage_Entry) Descriptor() ([]byte, []int) {
+	return file_protob_echo_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *EchoBroadcastMessage_Entry) GetSender() []byte {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *EchoBroadcastMessage_Entry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EchoBroadcastMessage_Entry) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+var File_protob_echo_proto protoreflect.FileDescriptor
+
+var file_protob_echo_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73, 0x73,
+	0x6c, 0x69, 0x62, 0x22, 0xbf, 0x01, 0x0a, 0x14, 0x45, 0x63, 0x68, 0x6f, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x44, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73,
+	0x73, 0x6c, 0x69, 0x62, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x4b, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x64,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x74, 0x73, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protob_echo_proto_rawDescOnce sync.Once
+	file_protob_echo_proto_rawDescData = file_protob_echo_proto_rawDesc
+)
+
+func file_protob_echo_proto_rawDescGZIP() []byte {
+	file_protob_echo_proto_rawDescOnce.Do(func() {
+		file_protob_echo_proto_rawDescData = protoimpl.X.CompressGZIP(file_protob_echo_proto_rawDescData)
+	})
+	return file_protob_echo_proto_rawDescData
+}
+
+var file_protob_echo_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_protob_echo_proto_goTypes = []interface{}{
+	(*EchoBroadcastMessage)(nil),       // 0: binance.tsslib.EchoBroadcastMessage
+	(*EchoBroadcastMessage_Entry)(nil), // 1: binance.tsslib.EchoBroadcastMessage.Entry
+}
+var file_protob_echo_proto_depIdxs = []int32{
+	1, // 0: binance.tsslib.EchoBroadcastMessage.entries:type_name -> binance.tsslib.EchoBroadcastMessage.Entry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_protob_echo_proto_init() }
+func file_protob_echo_proto_init() {
+	if File_protob_echo_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protob_echo_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoBroadcastMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_echo_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoBroadcastMessage_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protob_echo_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protob_echo_proto_goTypes,
+		DependencyIndexes: file_protob_echo_proto_depIdxs,
+		MessageInfos:      file_protob_echo_proto_msgTypes,
+	}.Build()
+	File_protob_echo_proto = out.File
+	file_protob_echo_proto_rawDesc = nil
+	file_protob_echo_proto_goTypes = nil
+	file_protob_echo_proto_depIdxs = nil
+}
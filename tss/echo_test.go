@@ -0,0 +1,75 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/eddsa/signing"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func withEcho(_ int, params *tss.Parameters) {
+	params.SetEchoBroadcast()
+}
+
+func isEcho(msg tss.ParsedMessage) bool {
+	_, ok := msg.Content().(*tss.EchoBroadcastMessage)
+	return ok
+}
+
+func TestEchoBroadcast(t *testing.T) {
+	steppers, keys, _ := newSigners(t, withEcho)
+	echoes := 0
+	results, err := runSteps(t, steppers, func(msg tss.ParsedMessage, _ *tss.PartyID) tss.ParsedMessage {
+		if isEcho(msg) {
+			echoes++
+		}
+		return msg
+	})
+	assert.Nil(t, err)
+	assert.NotZero(t, echoes, "the parties must exchange echoes")
+	for _, result := range results {
+		verifySignature(t, keys[0], result)
+	}
+}
+
+func TestEchoBroadcastEquivocation(t *testing.T) {
+	steppers, _, signPIDs := newSigners(t, withEcho)
+	sender, victim := signPIDs[1], signPIDs[2]
+	_, err := runSteps(t, steppers, func(msg tss.ParsedMessage, to *tss.PartyID) tss.ParsedMessage {
+		// the sender gives one party a different broadcast than the others
+		if r1msg, ok := msg.Content().(*signing.SignRound1Message); ok && msg.GetFrom() == sender && to == victim {
+			r1msg.Commitment = new(big.Int).Add(new(big.Int).SetBytes(r1msg.Commitment), big.NewInt(1)).Bytes()
+		}
+		return msg
+	})
+	if assert.NotNil(t, err) {
+		assert.Empty(t, err.Culprits(), "an unsigned echo does not prove who lied")
+	}
+}
+
+func TestEchoBroadcastWaitsForEchoes(t *testing.T) {
+	steppers, _, signPIDs := newSigners(t, withEcho)
+	silent := signPIDs[1]
+	results, err := runSteps(t, steppers, func(msg tss.ParsedMessage, _ *tss.PartyID) tss.ParsedMessage {
+		if isEcho(msg) && msg.GetFrom() == silent {
+			return nil
+		}
+		return msg
+	})
+	assert.Nil(t, err)
+	for i, S := range steppers {
+		assert.Nil(t, results[i], "no party may move on without every echo")
+		if S.Party().PartyID() != silent {
+			assert.Contains(t, S.Party().WaitingFor(), silent)
+		}
+	}
+}
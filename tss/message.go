@@ -49,6 +49,13 @@ type (
 		ValidateBasic() bool
 	}
 
+	// SlottedMessageContent is implemented by the content of a message type that a party sends more than once in a
+	// session, e.g. in every step of a batch. The slot tells its messages apart from the others of the same sender.
+	SlottedMessageContent interface {
+		MessageContent
+		Slot() string
+	}
+
 	// MessageRouting holds the full routing information for the message, consumed by the transport
 	MessageRouting struct {
 		// which participant this message came from
@@ -136,8 +143,14 @@ func (mm *MessageImpl) IsToOldAndNewCommittees() bool {
 	return mm.wire.IsToOldAndNewCommittees
 }
 
+// WireBytes returns the bytes to send over the wire. The session ID of the message, when it has one, is sent with
+// it, so that the receiver can check that the message belongs to its session.
 func (mm *MessageImpl) WireBytes() ([]byte, *MessageRouting, error) {
-	bz, err := proto.Marshal(mm.wire.Message)
+	var wire proto.Message = mm.wire.Message
+	if len(mm.wire.SessionId) != 0 {
+		wire = &SessionWireMessage{SessionId: mm.wire.SessionId, Message: mm.wire.Message}
+	}
+	bz, err := proto.Marshal(wire)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,181 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Observers
+//
+// An Observer set with Parameters.SetObserver is told what a party does, e.g. to export per-round latencies or to
+// find the peers that are slow to send their messages. Its methods are called synchronously, some with the lock of
+// the party held, so they must return quickly and must not call back into the party. One observer may be shared by
+// parties running concurrently; each call names the party it is about.
+
+// Names of the proofs reported to Observer.ProofGenerated and Observer.ProofVerified. The MtA proofs are those of
+// Bob; their generation includes the verification of the range proof of Alice.
+const (
+	ProofDLN      = "dln"
+	ProofMod      = "mod"
+	ProofFac      = "fac"
+	ProofPaillier = "paillier"
+	ProofRange    = "range"
+	ProofMtA      = "mta"
+	ProofMtAWC    = "mta-wc"
+	ProofSchnorr  = "schnorr"
+	ProofSchnorrV = "schnorr-v"
+)
+
+type (
+	Observer interface {
+		// PartyStarted is called when Start is called on the party, or when it resumes from a checkpoint
+		PartyStarted(party *PartyID, task string)
+		// PartyFinished is called when the party finishes, with a nil error, and with each error returned by Start,
+		// by Update for a valid message or sent to the abort channel. `elapsed` is the time since the party started.
+		PartyFinished(party *PartyID, task string, err *Error, elapsed time.Duration)
+		// RoundStarted is called once the round has sent its messages; RoundFinished measures the round from before
+		RoundStarted(party *PartyID, task string, round int)
+		RoundFinished(party *PartyID, task string, round int, elapsed time.Duration)
+		// MessageSent is called for every message stamped by the party; `to` is nil for a broadcast. `size` is
+		// that of the wire message, after the secure channel has sealed it.
+		MessageSent(party *PartyID, msgType string, size int, to []*PartyID)
+		MessageReceived(party *PartyID, msgType string, size int, from *PartyID)
+		// PeerWaited is called when a message from `peer` is accepted, with the time since the current round of
+		// the party started. A message received ahead of its round is reported against the round before it.
+		PeerWaited(party *PartyID, task string, round int, peer *PartyID, waited time.Duration)
+		ProofGenerated(party *PartyID, proof string, elapsed time.Duration)
+		ProofVerified(party *PartyID, proof string, ok bool, elapsed time.Duration)
+	}
+
+	// NoopObserver ignores every event. Embed it to implement only some of the methods of Observer.
+	NoopObserver struct{}
+
+	// instanceObserver reports the proofs of an instance of a batch; its messages are reported by the batch
+	instanceObserver struct {
+		Observer
+	}
+
+	// observerState holds the times the events of a party are measured from
+	observerState struct {
+		started      time.Time
+		roundStarted time.Time
+	}
+)
+
+var _ Observer = NoopObserver{}
+
+func (NoopObserver) PartyStarted(*PartyID, string)                             {}
+func (NoopObserver) PartyFinished(*PartyID, string, *Error, time.Duration)     {}
+func (NoopObserver) RoundStarted(*PartyID, string, int)                        {}
+func (NoopObserver) RoundFinished(*PartyID, string, int, time.Duration)        {}
+func (NoopObserver) MessageSent(*PartyID, string, int, []*PartyID)             {}
+func (NoopObserver) MessageReceived(*PartyID, string, int, *PartyID)           {}
+func (NoopObserver) PeerWaited(*PartyID, string, int, *PartyID, time.Duration) {}
+func (NoopObserver) ProofGenerated(*PartyID, string, time.Duration)            {}
+func (NoopObserver) ProofVerified(*PartyID, string, bool, time.Duration)       {}
+
+func (instanceObserver) MessageSent(*PartyID, string, int, []*PartyID) {}
+
+// ----- //
+
+// SetObserver makes the party report its events to `observer`
+func (params *Parameters) SetObserver(observer Observer) {
+	params.observer = observer
+}
+
+// ObserveProofGenerated reports a proof of the party that took since `start` to generate
+func (params *Parameters) ObserveProofGenerated(proof string, start time.Time) {
+	if params.observer == nil {
+		return
+	}
+	params.observer.ProofGenerated(params.partyID, proof, time.Since(start))
+}
+
+// ObserveProofVerified reports a proof of a peer that took since `start` to verify
+func (params *Parameters) ObserveProofVerified(proof string, ok bool, start time.Time) {
+	if params.observer == nil {
+		return
+	}
+	params.observer.ProofVerified(params.partyID, proof, ok, time.Since(start))
+}
+
+func (params *Parameters) observeSent(msg Message) {
+	if params.observer == nil {
+		return
+	}
+	var to []*PartyID
+	if !msg.IsBroadcast() {
+		to = msg.GetTo()
+	}
+	params.observer.MessageSent(params.partyID, msg.Type(), proto.Size(msg.WireMsg()), to)
+}
+
+// ----- //
+
+func (p *BaseParty) observation() *observerState {
+	return &p.obs
+}
+
+func observerOf(p Party) (Observer, *Parameters) {
+	params := p.FirstRound().Params()
+	return params.observer, params
+}
+
+func observePartyStarted(p Party, task string) {
+	observer, params := observerOf(p)
+	if observer == nil {
+		return
+	}
+	obs := p.observation()
+	obs.started = time.Now()
+	observer.PartyStarted(params.partyID, task)
+}
+
+func observePartyFinished(p Party, task string, err *Error) {
+	observer, params := observerOf(p)
+	if observer == nil {
+		return
+	}
+	observer.PartyFinished(params.partyID, task, err, time.Since(p.observation().started))
+}
+
+// observeRoundStarted is called once the round has started, as its number is only known then
+func observeRoundStarted(p Party, task string, started time.Time) {
+	observer, params := observerOf(p)
+	if observer == nil || p.round() == nil {
+		return
+	}
+	p.observation().roundStarted = started
+	observer.RoundStarted(params.partyID, task, p.round().RoundNumber())
+}
+
+func observeRoundFinished(p Party, task string) {
+	observer, params := observerOf(p)
+	if observer == nil || p.round() == nil {
+		return
+	}
+	observer.RoundFinished(params.partyID, task, p.round().RoundNumber(), time.Since(p.observation().roundStarted))
+}
+
+func observeReceived(p Party, msg ParsedMessage) {
+	observer, params := observerOf(p)
+	if observer == nil || msg == nil {
+		return
+	}
+	observer.MessageReceived(params.partyID, msg.Type(), proto.Size(msg.WireMsg()), msg.GetFrom())
+}
+
+func observePeerWaited(p Party, task string, msg ParsedMessage) {
+	observer, params := observerOf(p)
+	if observer == nil || p.round() == nil {
+		return
+	}
+	observer.PeerWaited(params.partyID, task, p.round().RoundNumber(), msg.GetFrom(), time.Since(p.observation().roundStarted))
+}
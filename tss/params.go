@@ -7,9 +7,18 @@
 package tss
 
 import (
+	"bytes"
+	"context"
 	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
 	"runtime"
 	"time"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
 )
 
 type (
@@ -21,11 +30,29 @@ type (
 		threshold           int
 		concurrency         int
 		safePrimeGenTimeout time.Duration
+		rand                io.Reader
 		// proof session info
-		nonce int
+		sessionID []byte
 		// for keygen
 		noProofMod bool
 		noProofFac bool
+		// for signing
+		identifiableAbort bool
+		// echo broadcast
+		echo   bool
+		echoTo []*PartyID
+		// secure channel
+		secure *secureChannel
+		// cancellation and round timeouts
+		ctx          context.Context
+		cancel       context.CancelFunc
+		roundTimeout time.Duration
+		abortOut     chan<- *Error
+		// session transcript
+		transcript *Transcript
+		// metrics and tracing
+		observer Observer
+		logger   common.StructuredLogger
 	}
 
 	ReSharingParameters struct {
@@ -50,6 +77,7 @@ func NewParameters(ec elliptic.Curve, ctx *PeerContext, partyID *PartyID, partyC
 		threshold:           threshold,
 		concurrency:         runtime.GOMAXPROCS(0),
 		safePrimeGenTimeout: defaultSafePrimeGenTimeout,
+		rand:                rand.Reader,
 	}
 }
 
@@ -90,6 +118,46 @@ func (params *Parameters) SetSafePrimeGenTimeout(timeout time.Duration) {
 	params.safePrimeGenTimeout = timeout
 }
 
+// Rand returns the source of all the randomness drawn by the party, crypto/rand.Reader by default
+func (params *Parameters) Rand() io.Reader {
+	return params.rand
+}
+
+// SetRand replaces the source of randomness, e.g. with a seeded DRBG to make the messages of a party reproducible
+// in tests and test vectors. The party reads it from one goroutine at a time. Pre-params generated by keygen and
+// re-sharing do not use it. Never use a predictable source outside of tests.
+func (params *Parameters) SetRand(rand io.Reader) {
+	params.rand = rand
+}
+
+// SetLogger replaces the logger of the party, common.DefaultLogger() by default
+func (params *Parameters) SetLogger(logger common.StructuredLogger) {
+	params.logger = logger
+}
+
+// Logger returns the logger of the party with its session, party, task and round as fields. The task is left out
+// when empty and the round when not positive.
+func (params *Parameters) Logger(task string, round int) common.StructuredLogger {
+	logger := params.logger
+	if logger == nil {
+		logger = common.DefaultLogger()
+	}
+	fields := make([]common.Field, 0, 4)
+	if len(params.sessionID) != 0 {
+		fields = append(fields, common.StringField("session", hex.EncodeToString(params.sessionID)))
+	}
+	if params.partyID != nil {
+		fields = append(fields, common.StringField("party", params.partyID.String()))
+	}
+	if task != "" {
+		fields = append(fields, common.StringField("task", task))
+	}
+	if 0 < round {
+		fields = append(fields, common.IntField("round", round))
+	}
+	return logger.With(fields...)
+}
+
 func (params *Parameters) NoProofMod() bool {
 	return params.noProofMod
 }
@@ -106,6 +174,146 @@ func (params *Parameters) SetNoProofFac() {
 	params.noProofFac = true
 }
 
+func (params *Parameters) IdentifiableAbort() bool {
+	return params.identifiableAbort
+}
+
+// SetIdentifiableAbort enables the blame phase of ECDSA signing. When the final consistency check or the
+// signature verification fails, the signers reveal their ephemeral values so that the cheating parties
+// can be named in the returned error. A party that does not reveal is named once the round times out, so signing
+// refuses to start unless a round timeout has been set with SetContext. The session must not be retried with the
+// same nonces afterwards.
+func (params *Parameters) SetIdentifiableAbort() {
+	params.identifiableAbort = true
+}
+
+func (params *Parameters) SessionID() []byte {
+	return params.sessionID
+}
+
+// SetSessionID binds the protocol run to a caller-chosen session, e.g. a request ID agreed by all parties.
+// It is hashed into the SSID of every protocol, which the ZK proofs use as their context, and carried in the
+// MessageWrapper of every outgoing message. All parties of a run must set the same session ID.
+func (params *Parameters) SetSessionID(sessionID []byte) {
+	params.sessionID = append([]byte(nil), sessionID...)
+}
+
+// SSIDNonce returns the value hashed into the SSID for the session ID; zero when no session ID is set
+func (params *Parameters) SSIDNonce() *big.Int {
+	if len(params.sessionID) == 0 {
+		return big.NewInt(0)
+	}
+	return new(big.Int).SetBytes(common.SHA512_256(params.sessionID))
+}
+
+// StampMessage sets the session ID on an outgoing message, and seals it when the secure channel is enabled. A
+// message that could not be sealed must not be sent.
+func (params *Parameters) StampMessage(msg Message) (Message, error) {
+	if len(params.sessionID) != 0 {
+		msg.WireMsg().SessionId = params.sessionID
+	}
+	params.transcript.recordMessage(TranscriptSent, msg)
+	if params.secure != nil {
+		if err := params.seal(msg); err != nil {
+			return nil, fmt.Errorf("failed to seal a message: %v", err)
+		}
+	}
+	params.observeSent(msg)
+	return msg, nil
+}
+
+// ValidateSessionID returns an error when `msg` was not sent in this session
+func (params *Parameters) ValidateSessionID(msg ParsedMessage) error {
+	if !bytes.Equal(msg.WireMsg().GetSessionId(), params.sessionID) {
+		return fmt.Errorf("received msg from another session (%x != %x): %s", msg.WireMsg().GetSessionId(), params.sessionID, msg)
+	}
+	return nil
+}
+
+func (params *Parameters) EchoBroadcast() bool {
+	return params.echo
+}
+
+// SetEchoBroadcast removes the need for a reliable broadcast channel. After every round the parties exchange
+// digests of the broadcast messages they received, and abort naming the sender if any two saw different values.
+// The echoes are sent along with the party's other messages; every party must enable it.
+func (params *Parameters) SetEchoBroadcast() {
+	params.echo = true
+}
+
+func (params *Parameters) SecureChannel() bool {
+	return params.secure != nil
+}
+
+// SetSecureChannel signs every outgoing message with `identity` and encrypts point-to-point messages to their
+// recipient. `peers` holds the identities of the other parties keyed by PartyID.Id; every party must enable it.
+// Incoming messages are opened by Update and UpdateFromBytes, which reject any message that is not sealed. The
+// session ID must be set first, as the signatures bind the messages to it.
+func (params *Parameters) SetSecureChannel(identity *Identity, peers map[string]*PeerIdentity) error {
+	secure, err := newSecureChannel(identity, peers, params.Parties().IDs(), params.PartyID(), params.sessionID)
+	if err != nil {
+		return err
+	}
+	params.secure = secure
+	return nil
+}
+
+// Context is done once the party has been aborted; long-running work in the rounds stops early when it is
+func (params *Parameters) Context() context.Context {
+	if params.ctx == nil {
+		return context.Background()
+	}
+	return params.ctx
+}
+
+func (params *Parameters) RoundTimeout() time.Duration {
+	return params.roundTimeout
+}
+
+// SetContext lets the party be aborted. When `ctx` is done, or a round has waited longer than `roundTimeout` for
+// its messages (zero means no limit), the party sends a *Error for the current round to `errCh`, naming the parties
+// it is still waiting for as culprits. Work in progress is cancelled and later updates are rejected.
+// `errCh` should be buffered or read until the party has finished.
+func (params *Parameters) SetContext(ctx context.Context, roundTimeout time.Duration, errCh chan<- *Error) {
+	params.ctx, params.cancel = context.WithCancel(ctx)
+	params.roundTimeout = roundTimeout
+	params.abortOut = errCh
+}
+
+// BatchInstance returns the parameters of the instance `index` of a batch of protocol runs under these parameters.
+// The instance shares the curve, parties, options, randomness, context, observer and logger, and its session ID is
+// that of the batch with the index appended, so that every instance has an SSID of its own. Its messages are carried
+// by the batch, which provides the secure channel, echo broadcast, transcript and timeouts.
+func (params *Parameters) BatchInstance(index int) *Parameters {
+	logger := params.logger
+	if logger == nil {
+		logger = common.DefaultLogger()
+	}
+	var observer Observer
+	if params.observer != nil {
+		observer = instanceObserver{params.observer}
+	}
+	sessionID := append(append([]byte(nil), params.sessionID...), []byte(fmt.Sprintf("/batch/%d", index))...)
+	return &Parameters{
+		ec:                  params.ec,
+		partyID:             params.partyID,
+		parties:             params.parties,
+		partyCount:          params.partyCount,
+		threshold:           params.threshold,
+		concurrency:         params.concurrency,
+		safePrimeGenTimeout: params.safePrimeGenTimeout,
+		rand:                params.rand,
+		sessionID:           sessionID,
+		noProofMod:          params.noProofMod,
+		noProofFac:          params.noProofFac,
+		identifiableAbort:   params.identifiableAbort,
+		ctx:                 params.ctx,
+		roundTimeout:        params.roundTimeout,
+		observer:            observer,
+		logger:              logger.With(common.IntField("instance", index)),
+	}
+}
+
 // ----- //
 
 // Exported, used in `tss` client
@@ -119,6 +327,22 @@ func NewReSharingParameters(ec elliptic.Curve, ctx, newCtx *PeerContext, partyID
 	}
 }
 
+// SetEchoBroadcast enables echo broadcast across both committees
+func (rgParams *ReSharingParameters) SetEchoBroadcast() {
+	rgParams.Parameters.SetEchoBroadcast()
+	rgParams.echoTo = rgParams.OldAndNewParties()
+}
+
+// SetSecureChannel enables the secure channel across both committees
+func (rgParams *ReSharingParameters) SetSecureChannel(identity *Identity, peers map[string]*PeerIdentity) error {
+	secure, err := newSecureChannel(identity, peers, rgParams.OldAndNewParties(), rgParams.PartyID(), rgParams.sessionID)
+	if err != nil {
+		return err
+	}
+	rgParams.secure = secure
+	return nil
+}
+
 func (rgParams *ReSharingParameters) OldParties() *PeerContext {
 	return rgParams.Parties() // wr use the original method for old parties
 }
@@ -10,6 +10,9 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
 
 	"github.com/bnb-chain/tss-lib/v2/common"
 )
@@ -36,12 +39,40 @@ type Party interface {
 	advance()
 	lock()
 	unlock()
+	acceptedMessage(msg ParsedMessage) (duplicate bool, err *Error)
+	recordMessage(msg ParsedMessage)
+	storeEcho(msg ParsedMessage) *Error
+	echoReady(round Round) (bool, *Error)
+	startWatchdog(params *Parameters)
+	stopWatchdog()
+	abortError() *Error
+	halt(err *Error)
+	checkpoints() *checkpointState
+	observation() *observerState
+	output() *outputState
+	checkpointMessages() ([][]byte, error)
+	checkpointEchoes() ([][]byte, []int, error)
+	restoreEchoes(echoes [][]byte, sent []int, parties []*PartyID) error
 }
 
 type BaseParty struct {
 	mtx        sync.Mutex
 	rnd        Round
 	FirstRound Round
+
+	// the messages accepted so far, keyed by sender and message type
+	accepted map[string]ParsedMessage
+	echo     echoState
+
+	// set when the party is aborted by its context or a round timeout, or halted
+	watch   *watchdog
+	aborted *Error
+
+	cp checkpointState
+
+	obs observerState
+
+	out outputState
 }
 
 func (p *BaseParty) Running() bool {
@@ -51,9 +82,18 @@ func (p *BaseParty) Running() bool {
 func (p *BaseParty) WaitingFor() []*PartyID {
 	p.lock()
 	defer p.unlock()
+	return p.waitingFor()
+}
+
+func (p *BaseParty) waitingFor() []*PartyID {
 	if p.rnd == nil {
 		return []*PartyID{}
 	}
+	if p.rnd.CanProceed() {
+		if missing := p.missingEchoes(p.rnd); 0 < len(missing) {
+			return missing
+		}
+	}
 	return p.rnd.WaitingFor()
 }
 
@@ -99,6 +139,12 @@ func (p *BaseParty) round() Round {
 
 func (p *BaseParty) advance() {
 	p.rnd = p.rnd.NextRound()
+	p.cp.step++
+	if p.rnd == nil {
+		p.stopWatchdog()
+		return
+	}
+	p.watch.roundAdvanced()
 }
 
 func (p *BaseParty) lock() {
@@ -109,75 +155,253 @@ func (p *BaseParty) unlock() {
 	p.mtx.Unlock()
 }
 
+// every message type is sent once per round, so the sender and type identify the slot a message is stored in
+func messageSlot(senderKey []byte, msgType string) string {
+	return fmt.Sprintf("%x/%s", senderKey, msgType)
+}
+
+// slotType is the type of a message, followed by its slot when the type is sent more than once
+func slotType(msg ParsedMessage) string {
+	if content, ok := msg.Content().(SlottedMessageContent); ok {
+		return msg.Type() + "#" + content.Slot()
+	}
+	return msg.Type()
+}
+
+func acceptedMessageKey(msg ParsedMessage) string {
+	return messageSlot(msg.GetFrom().GetKey(), slotType(msg))
+}
+
+// acceptedMessage reports whether a message for the same slot has already been accepted.
+// An exact duplicate is reported as such; a different message is an equivocation by the sender.
+func (p *BaseParty) acceptedMessage(msg ParsedMessage) (bool, *Error) {
+	prev, ok := p.accepted[acceptedMessageKey(msg)]
+	if !ok {
+		return false, nil
+	}
+	if proto.Equal(prev.Content(), msg.Content()) {
+		return true, nil
+	}
+	return false, p.WrapError(fmt.Errorf("equivocation: received a conflicting %s from party %s", msg.Type(), msg.GetFrom()), msg.GetFrom())
+}
+
+func (p *BaseParty) recordMessage(msg ParsedMessage) {
+	if p.accepted == nil {
+		p.accepted = make(map[string]ParsedMessage)
+	}
+	p.accepted[acceptedMessageKey(msg)] = msg
+}
+
 // ----- //
 
+// an implementation of Start that is shared across the different types of parties.
+// what the first round sends is passed on to the party's channels once the party has been released.
 func BaseStart(p Party, task string, prepare ...func(Round) *Error) *Error {
+	defer flush(p)
+	return baseStart(p, task, prepare...)
+}
+
+func baseStart(p Party, task string, prepare ...func(Round) *Error) (err *Error) {
+	t := transcriptOf(p)
+	defer func() { t.recordError(err) }()
 	p.lock()
 	defer p.unlock()
+	defer func() {
+		if err != nil {
+			observePartyFinished(p, task, err)
+		}
+	}()
+	defer func() {
+		if err == nil {
+			err = sendError(p)
+		}
+	}()
 	if p.PartyID() == nil || !p.PartyID().ValidateBasic() {
 		return p.WrapError(fmt.Errorf("could not start. this party has an invalid PartyID: %+v", p.PartyID()))
 	}
 	if p.round() != nil {
 		return p.WrapError(errors.New("could not start. this party is in an unexpected state. use the constructor and Start()"))
 	}
+	if 1 < len(prepare) {
+		return p.WrapError(errors.New("too many prepare functions given to Start(); 1 allowed"))
+	}
+	// the party cannot go on once its first round has failed
+	defer func() {
+		if err != nil {
+			p.stopWatchdog()
+		}
+	}()
+	if p.checkpoints().resume != nil {
+		return resume(p, task)
+	}
 	round := p.FirstRound()
 	if err := p.setRound(round); err != nil {
 		return err
 	}
-	if 1 < len(prepare) {
-		return p.WrapError(errors.New("too many prepare functions given to Start(); 1 allowed"))
-	}
+	observePartyStarted(p, task)
+	p.startWatchdog(round.Params())
 	if len(prepare) == 1 {
 		if err := prepare[0](round); err != nil {
 			return err
 		}
 	}
-	common.Logger.Infof("party %s: %s round %d starting", p.round().Params().PartyID(), task, 1)
-	defer func() {
-		common.Logger.Debugf("party %s: %s round %d finished", p.round().Params().PartyID(), task, 1)
-	}()
-	return p.round().Start()
+	logger := round.Params().Logger(task, 1)
+	logger.Info("round starting")
+	defer logger.Debug("round start finished")
+	started := time.Now()
+	if err := p.round().Start(); err != nil {
+		return err
+	}
+	observeRoundStarted(p, task, started)
+	// the peers may be waiting for this party's echo of a round which needed no messages
+	if p.round().CanProceed() {
+		if _, err := p.echoReady(p.round()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// an implementation of Update that is shared across the different types of parties (keygen, signing, dynamic groups).
+// a replayed copy of an accepted message is ignored, while a different message in its place is reported as an equivocation.
+// as in BaseStart, what the party sends is passed on once it has been released.
+func BaseUpdate(p Party, msg ParsedMessage, task string) (bool, *Error) {
+	defer flush(p)
+	return baseUpdate(p, msg, task)
 }
 
-// an implementation of Update that is shared across the different types of parties (keygen, signing, dynamic groups)
-func BaseUpdate(p Party, msg ParsedMessage, task string) (ok bool, err *Error) {
+func baseUpdate(p Party, msg ParsedMessage, task string) (ok bool, err *Error) {
+	t := transcriptOf(p)
+	defer func() { t.recordError(err) }()
+	echo := isEchoBroadcast(msg)
 	// fast-fail on an invalid message; do not lock the mutex yet
-	if _, err := p.ValidateMessage(msg); err != nil {
+	if echo {
+		if err := validateEcho(p, msg); err != nil {
+			t.recordMessage(TranscriptReceived, msg)
+			return false, err
+		}
+	} else if _, err := p.ValidateMessage(msg); err != nil {
+		t.recordMessage(TranscriptReceived, msg)
 		return false, err
 	}
-	// lock the mutex. need this mtx unlock hook; L108 is recursive so cannot use defer
-	r := func(ok bool, err *Error) (bool, *Error) {
-		p.unlock()
-		return ok, err
-	}
 	p.lock() // data is written to P state below
-	common.Logger.Debugf("party %s received message: %s", p.PartyID(), msg.String())
-	if p.round() != nil {
-		common.Logger.Debugf("party %s round %d update: %s", p.PartyID(), p.round().RoundNumber(), msg.String())
+	defer p.unlock()
+	defer func() {
+		if err != nil {
+			observePartyFinished(p, task, err)
+		}
+	}()
+	defer func() {
+		if err == nil {
+			if err = sendError(p); err != nil {
+				ok = false
+			}
+		}
+	}()
+	t.recordMessage(TranscriptReceived, msg)
+	observeReceived(p, msg)
+	if err := p.abortError(); err != nil {
+		return false, err
+	}
+	logger := loggerOf(p, task)
+	logger.Debug("received message", common.StringField("msg", msg.String()))
+	if echo {
+		if err := p.storeEcho(msg); err != nil {
+			return false, err
+		}
+	} else {
+		duplicate, err := p.acceptedMessage(msg)
+		if err != nil {
+			return false, err
+		}
+		if duplicate {
+			logger.Debug("ignored a duplicate message", common.StringField("msg", msg.String()))
+			return true, nil
+		}
+		if ok, err := p.StoreMessage(msg); err != nil || !ok {
+			return false, err
+		}
+		p.recordMessage(msg)
+		observePeerWaited(p, task, msg)
+	}
+	if err := proceed(p, task); err != nil {
+		// the rounds cannot go on after an error of their own
+		p.stopWatchdog()
+		return false, err
 	}
-	if ok, err := p.StoreMessage(msg); err != nil || !ok {
-		return r(false, err)
+	return true, nil
+}
+
+// sendError fails the party when one of the messages it sent could not be stamped, e.g. sealed for the secure channel
+func sendError(p Party) *Error {
+	if err := p.output().box.Err(); err != nil {
+		p.stopWatchdog()
+		return p.WrapError(err)
 	}
+	return nil
+}
+
+// loggerOf returns the logger of a party at its current round
+func loggerOf(p Party, task string) common.StructuredLogger {
+	round := 0
 	if p.round() != nil {
-		common.Logger.Debugf("party %s: %s round %d update", p.round().Params().PartyID(), task, p.round().RoundNumber())
+		round = p.round().RoundNumber()
+	}
+	return p.FirstRound().Params().Logger(task, round)
+}
+
+// proceed moves the party through every round that has received all of its messages
+func proceed(p Party, task string) *Error {
+	for p.round() != nil {
+		loggerOf(p, task).Debug("round update")
 		if _, err := p.round().Update(); err != nil {
-			return r(false, err)
-		}
-		if p.round().CanProceed() {
-			if p.advance(); p.round() != nil {
-				if err := p.round().Start(); err != nil {
-					return r(false, err)
-				}
-				rndNum := p.round().RoundNumber()
-				common.Logger.Infof("party %s: %s round %d started", p.round().Params().PartyID(), task, rndNum)
-			} else {
-				// finished! the round implementation will have sent the data through the `end` channel.
-				common.Logger.Infof("party %s: %s finished!", p.PartyID(), task)
-			}
-			p.unlock()                      // recursive so can't defer after return
-			return BaseUpdate(p, msg, task) // re-run round update or finish)
+			return err
+		}
+		if !p.round().CanProceed() {
+			return nil
+		}
+		if ready, err := p.echoReady(p.round()); err != nil || !ready {
+			return err
+		}
+		observeRoundFinished(p, task)
+		if p.advance(); p.round() == nil {
+			// finished! the round implementation will have sent the data through the `end` channel.
+			loggerOf(p, task).Info("finished!")
+			transcriptOf(p).record(TranscriptEvent{Kind: TranscriptFinished})
+			observePartyFinished(p, task, nil)
+			return nil
 		}
-		return r(true, nil)
+		started := time.Now()
+		if err := p.round().Start(); err != nil {
+			return err
+		}
+		observeRoundStarted(p, task, started)
+		if err := saveCheckpoint(p); err != nil {
+			// the party stops, and the peers must not see what the round sent, as a party resumed from the last
+			// checkpoint starts it again
+			p.output().box.Take()
+			p.halt(err)
+			return err
+		}
+		loggerOf(p, task).Info("round started")
+	}
+	return nil
+}
+
+// resume is called by BaseStart with the party locked in place of starting the first round
+func resume(p Party, task string) *Error {
+	round, sent, err := resumeRound(p)
+	if err != nil {
+		return err
+	}
+	if err := p.setRound(round); err != nil {
+		return err
 	}
-	return r(true, nil)
+	observePartyStarted(p, task)
+	p.startWatchdog(round.Params())
+	// the round was started before the checkpoint was taken; the peers may not have received what it sent
+	p.output().box.resend(sent)
+	loggerOf(p, task).Info("resumed")
+	// the messages of the round may have all been received before the checkpoint
+	return proceed(p, task)
 }
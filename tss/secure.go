@@ -0,0 +1,242 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+)
+
+// Secure channel
+//
+// When enabled with Parameters.SetSecureChannel, every outgoing message is wrapped in a SecureEnvelope signed
+// with the sender's static identity key, and point-to-point messages are also encrypted to the recipient with
+// NaCl box (X25519, XSalsa20-Poly1305). The signature covers the session ID and the routing of the message, and the
+// receiving party checks it against the identity of the claimed sender before the message is processed, so a relay
+// can neither read shares, spoof a sender, replay a message into another session nor deliver it to other parties.
+
+const (
+	IdentitySeedSize = 32
+	PeerIdentitySize = ed25519.PublicKeySize + 32
+
+	boxNonceSize = 24
+)
+
+var secureChannelDomain = []byte("tss-lib secure channel")
+
+type (
+	// Identity is the static key pair of a party for the secure channel
+	Identity struct {
+		seed       []byte
+		signingKey ed25519.PrivateKey
+		boxKey     [32]byte
+		public     *PeerIdentity
+	}
+
+	// PeerIdentity is the public half of an Identity, which must be known to the other parties
+	PeerIdentity struct {
+		SigningKey ed25519.PublicKey
+		BoxKey     [32]byte
+	}
+
+	secureChannel struct {
+		identity *Identity
+		// the identities of the other parties, keyed by PartyID.Id
+		peers map[string]*PeerIdentity
+	}
+)
+
+// NewIdentity generates a new identity using randomness from `rand`
+func NewIdentity(rand io.Reader) (*Identity, error) {
+	seed := make([]byte, IdentitySeedSize)
+	if _, err := io.ReadFull(rand, seed); err != nil {
+		return nil, err
+	}
+	return IdentityFromSeed(seed)
+}
+
+// IdentityFromSeed restores an identity from the seed returned by Seed
+func IdentityFromSeed(seed []byte) (*Identity, error) {
+	if len(seed) != IdentitySeedSize {
+		return nil, fmt.Errorf("identity seed must be %d bytes", IdentitySeedSize)
+	}
+	id := &Identity{
+		seed:       append([]byte(nil), seed...),
+		signingKey: ed25519.NewKeyFromSeed(seed),
+	}
+	copy(id.boxKey[:], common.SHA512_256(secureChannelDomain, []byte("box"), seed))
+	boxPub, err := curve25519.X25519(id.boxKey[:], curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+	id.public = &PeerIdentity{SigningKey: id.signingKey.Public().(ed25519.PublicKey)}
+	copy(id.public.BoxKey[:], boxPub)
+	return id, nil
+}
+
+// Seed returns the secret seed of the identity, to be kept in secure storage
+func (id *Identity) Seed() []byte {
+	return append([]byte(nil), id.seed...)
+}
+
+func (id *Identity) Public() *PeerIdentity {
+	return id.public
+}
+
+func (peer *PeerIdentity) Bytes() []byte {
+	return append(append([]byte(nil), peer.SigningKey...), peer.BoxKey[:]...)
+}
+
+func ParsePeerIdentity(bz []byte) (*PeerIdentity, error) {
+	if len(bz) != PeerIdentitySize {
+		return nil, fmt.Errorf("peer identity must be %d bytes", PeerIdentitySize)
+	}
+	peer := &PeerIdentity{SigningKey: append(ed25519.PublicKey(nil), bz[:ed25519.PublicKeySize]...)}
+	copy(peer.BoxKey[:], bz[ed25519.PublicKeySize:])
+	return peer, nil
+}
+
+// ----- //
+
+func newSecureChannel(identity *Identity, peers map[string]*PeerIdentity, parties []*PartyID, self *PartyID, sessionID []byte) (*secureChannel, error) {
+	if identity == nil {
+		return nil, errors.New("secure channel: identity is nil")
+	}
+	if len(sessionID) == 0 {
+		return nil, errors.New("secure channel: a session ID must be set first, so that sealed messages cannot be replayed into another session")
+	}
+	for _, Pj := range parties {
+		if bytes.Equal(Pj.GetKey(), self.GetKey()) {
+			continue
+		}
+		if peer, ok := peers[Pj.Id]; !ok || peer == nil || len(peer.SigningKey) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("secure channel: no identity for party %s", Pj)
+		}
+	}
+	return &secureChannel{identity: identity, peers: peers}, nil
+}
+
+func envelopeDigest(sessionID, sender []byte, env *SecureEnvelope) []byte {
+	broadcast := []byte{0}
+	if env.GetIsBroadcast() {
+		broadcast[0] = 1
+	}
+	in := [][]byte{secureChannelDomain, sessionID, sender, env.GetRecipient(), env.GetNonce(), env.GetPayload(), broadcast}
+	return common.SHA512_256(append(in, env.GetTo()...)...)
+}
+
+func (m *SecureEnvelope) ValidateBasic() bool {
+	if m == nil || len(m.GetPayload()) == 0 || len(m.GetSignature()) != ed25519.SignatureSize {
+		return false
+	}
+	if len(m.GetNonce()) == 0 {
+		return len(m.GetRecipient()) == 0
+	}
+	return len(m.GetNonce()) == boxNonceSize && 0 < len(m.GetRecipient())
+}
+
+// seal wraps the content of an outgoing message in a signed envelope, encrypted if the message has a single recipient
+func (params *Parameters) seal(msg Message) error {
+	if len(params.sessionID) == 0 {
+		return errors.New("secure channel: no session ID is set")
+	}
+	wire := msg.WireMsg()
+	payload, err := proto.Marshal(wire.Message)
+	if err != nil {
+		return err
+	}
+	env := &SecureEnvelope{IsBroadcast: wire.GetIsBroadcast()}
+	for _, Pj := range wire.GetTo() {
+		env.To = append(env.To, Pj.GetKey())
+	}
+	if to := wire.GetTo(); len(to) == 1 && !wire.GetIsBroadcast() {
+		peer, ok := params.secure.peers[to[0].GetId()]
+		if !ok {
+			return fmt.Errorf("secure channel: no identity for party %s", to[0].GetId())
+		}
+		var nonce [boxNonceSize]byte
+		if _, err := io.ReadFull(params.Rand(), nonce[:]); err != nil {
+			return err
+		}
+		env.Nonce, env.Recipient = nonce[:], to[0].GetKey()
+		payload = box.Seal(nil, payload, &nonce, &peer.BoxKey, &params.secure.identity.boxKey)
+	}
+	env.Payload = payload
+	env.Signature = ed25519.Sign(params.secure.identity.signingKey, envelopeDigest(params.sessionID, wire.GetFrom().GetKey(), env))
+	any, err := anypb.New(env)
+	if err != nil {
+		return err
+	}
+	wire.Message = any
+	return nil
+}
+
+// OpenMessage verifies and unwraps a message sealed by the secure channel, returning the inner message.
+// Without a secure channel the message is returned as it is.
+func (params *Parameters) OpenMessage(msg ParsedMessage) (ParsedMessage, error) {
+	if params.secure == nil || msg == nil {
+		return msg, nil
+	}
+	env, ok := msg.Content().(*SecureEnvelope)
+	if !ok || !env.ValidateBasic() {
+		return nil, fmt.Errorf("secure channel: received a msg without a valid envelope: %s", msg)
+	}
+	from := msg.GetFrom()
+	if from == nil || !from.ValidateBasic() {
+		return nil, fmt.Errorf("received msg with an invalid sender: %s", msg)
+	}
+	peer, ok := params.secure.peers[from.Id]
+	if !ok {
+		return nil, fmt.Errorf("secure channel: received a msg from unknown party %s", from)
+	}
+	if len(params.sessionID) == 0 || !ed25519.Verify(peer.SigningKey, envelopeDigest(params.sessionID, from.GetKey(), env), env.GetSignature()) {
+		return nil, fmt.Errorf("secure channel: invalid signature on a msg from party %s", from)
+	}
+	if env.GetIsBroadcast() != msg.IsBroadcast() {
+		return nil, fmt.Errorf("secure channel: received a msg from party %s with a broadcast flag it was not sent with", from)
+	}
+	if to := env.GetTo(); 0 < len(to) && !containsKey(to, params.PartyID().GetKey()) {
+		return nil, fmt.Errorf("secure channel: received a msg from party %s that is meant for other parties", from)
+	}
+	payload := env.GetPayload()
+	if 0 < len(env.GetNonce()) {
+		if !bytes.Equal(env.GetRecipient(), params.PartyID().GetKey()) {
+			return nil, fmt.Errorf("secure channel: received a msg from party %s that is meant for another party", from)
+		}
+		var nonce [boxNonceSize]byte
+		copy(nonce[:], env.GetNonce())
+		if payload, ok = box.Open(nil, payload, &nonce, &peer.BoxKey, &params.secure.identity.boxKey); !ok {
+			return nil, fmt.Errorf("secure channel: failed to decrypt a msg from party %s", from)
+		}
+	}
+	inner := new(anypb.Any)
+	if err := proto.Unmarshal(payload, inner); err != nil {
+		return nil, err
+	}
+	wire := proto.Clone(msg.WireMsg()).(*MessageWrapper)
+	wire.Message = inner
+	return parseWrappedMessage(wire, from)
+}
+
+func containsKey(keys [][]byte, key []byte) bool {
+	for _, k := range keys {
+		if bytes.Equal(k, key) {
+			return true
+		}
+	}
+	return false
+}
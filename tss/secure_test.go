@@ -0,0 +1,155 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+var testSessionID = []byte("session")
+
+// newIdentities returns an identity for each party, and the public identities keyed by PartyID.Id
+func newIdentities(t *testing.T, pIDs tss.SortedPartyIDs) ([]*tss.Identity, map[string]*tss.PeerIdentity) {
+	identities := make([]*tss.Identity, len(pIDs))
+	peers := make(map[string]*tss.PeerIdentity, len(pIDs))
+	for i, Pi := range pIDs {
+		identity, err := tss.NewIdentity(rand.Reader)
+		assert.NoError(t, err)
+		identities[i], peers[Pi.Id] = identity, identity.Public()
+	}
+	return identities, peers
+}
+
+// newSecureParams returns the parameters of each party with the secure channel enabled in `sessionID`
+func newSecureParams(t *testing.T, pIDs tss.SortedPartyIDs, sessionID []byte) []*tss.Parameters {
+	identities, peers := newIdentities(t, pIDs)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	params := make([]*tss.Parameters, len(pIDs))
+	for i := range pIDs {
+		params[i] = tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+		params[i].SetSessionID(sessionID)
+		assert.NoError(t, params[i].SetSecureChannel(identities[i], peers))
+	}
+	return params
+}
+
+// sealAndParse seals `msg` as a party with `params` would send it, and parses it as a transport would receive it
+func sealAndParse(t *testing.T, params *tss.Parameters, msg tss.ParsedMessage, isBroadcast bool) tss.ParsedMessage {
+	sealed, err := params.StampMessage(msg)
+	assert.NoError(t, err)
+	bz, _, err := sealed.WireBytes()
+	assert.NoError(t, err)
+	parsed, err := tss.ParseWireMessage(bz, msg.GetFrom(), isBroadcast)
+	assert.NoError(t, err)
+	return parsed
+}
+
+func TestSecureChannelRequiresSessionID(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	identities, peers := newIdentities(t, pIDs)
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	assert.Error(t, params.SetSecureChannel(identities[0], peers), "the secure channel must be bound to a session")
+	params.SetSessionID(testSessionID)
+	assert.NoError(t, params.SetSecureChannel(identities[0], peers))
+}
+
+func TestSecureChannel(t *testing.T) {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	identities, peers := newIdentities(t, signPIDs)
+	steppers, _, _ := newSigners(t, func(i int, params *tss.Parameters) {
+		params.SetSessionID(testSessionID)
+		assert.NoError(t, params.SetSecureChannel(identities[i], peers))
+	})
+	results, tssErr := runSteps(t, steppers, func(msg tss.ParsedMessage, _ *tss.PartyID) tss.ParsedMessage {
+		_, ok := msg.Content().(*tss.SecureEnvelope)
+		assert.True(t, ok, "every message must be sealed")
+		return msg
+	})
+	assert.Nil(t, tssErr)
+	for _, result := range results {
+		verifySignature(t, keys[0], result)
+	}
+}
+
+func TestSecureChannelRejectsReplay(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	msg := func() tss.ParsedMessage {
+		return tss.NewEchoBroadcastMessage(pIDs[0], nil, 1, nil)
+	}
+	// the parties use the same identities in two sessions
+	identities, peers := newIdentities(t, pIDs)
+	sessionParams := func(i int, sessionID []byte) *tss.Parameters {
+		params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[i], len(pIDs), 1)
+		params.SetSessionID(sessionID)
+		assert.NoError(t, params.SetSecureChannel(identities[i], peers))
+		return params
+	}
+	sealed := sealAndParse(t, sessionParams(0, []byte("first")), msg(), true)
+
+	_, err := sessionParams(1, []byte("first")).OpenMessage(sealed)
+	assert.NoError(t, err)
+	_, err = sessionParams(1, []byte("second")).OpenMessage(sealed)
+	assert.Error(t, err, "a message must not be accepted in another session")
+}
+
+func TestSecureChannelSignsRouting(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	params := newSecureParams(t, pIDs, testSessionID)
+
+	// a broadcast cannot be passed off as a point-to-point message, nor the other way around
+	broadcast := tss.NewEchoBroadcastMessage(pIDs[0], nil, 1, nil)
+	_, err := params[1].OpenMessage(sealAndParse(t, params[0], broadcast, false))
+	assert.Error(t, err)
+	_, err = params[1].OpenMessage(sealAndParse(t, params[0], tss.NewEchoBroadcastMessage(pIDs[0], nil, 1, nil), true))
+	assert.NoError(t, err)
+
+	// a broadcast to some of the parties cannot be delivered to the others
+	sealed := sealAndParse(t, params[0], tss.NewEchoBroadcastMessage(pIDs[0], []*tss.PartyID{pIDs[1]}, 1, nil), true)
+	_, err = params[1].OpenMessage(sealed)
+	assert.NoError(t, err)
+	_, err = params[2].OpenMessage(sealed)
+	assert.Error(t, err, "a message must not be accepted by a party it was not sent to")
+}
+
+func TestSecureChannelSealError(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	identities, peers := newIdentities(t, pIDs)
+	steppers := make([]*tss.StepParty, len(pIDs))
+	for i := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+		params.SetSessionID(testSessionID)
+		own := make(map[string]*tss.PeerIdentity, len(peers))
+		for id, peer := range peers {
+			own[id] = peer
+		}
+		assert.NoError(t, params.SetSecureChannel(identities[i], own))
+		if i == 0 {
+			// party 0 loses the identity of party 1, so it cannot encrypt the share of party 1
+			delete(own, pIDs[1].Id)
+		}
+		var err error
+		steppers[i], err = tss.NewStepParty(keygen.NewLocalParty(params, nil, nil))
+		assert.NoError(t, err)
+	}
+	_, err := runSteps(t, steppers, func(msg tss.ParsedMessage, to *tss.PartyID) tss.ParsedMessage {
+		if msg.GetFrom() == pIDs[0] && !msg.IsBroadcast() {
+			assert.NotEqual(t, pIDs[1], to, "the share of party 1 must not be sent unsealed")
+		}
+		return msg
+	})
+	if assert.NotNil(t, err, "the party must fail when a message cannot be sealed") {
+		assert.Equal(t, pIDs[0], err.Victim())
+	}
+}
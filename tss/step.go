@@ -0,0 +1,177 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"errors"
+	"sync"
+)
+
+// Step API
+//
+// The rounds of a party never block on a channel: they queue the messages they send, and the result of the
+// protocol, in the Outbox of their party. A party made by a constructor of the channel API passes its outbox on to
+// its out and end channels once Start or Update has released the party, so a slow consumer of the channels delays
+// only the caller that is sending to it. A StepParty instead returns the outbox from each Step, which suits callers
+// that are not long-running processes, such as serverless functions or event-sourced services.
+
+type (
+	// Outbox holds what the rounds of a party have sent and not yet been passed on. Each party has its own, so that
+	// parties sharing their Parameters keep their messages apart.
+	Outbox struct {
+		params   *Parameters
+		mtx      sync.Mutex
+		msgs     []Message
+		result   interface{}
+		finished bool
+		// the first message that could not be stamped
+		err error
+	}
+
+	// outputState is where a party of the channel API passes on its outbox
+	outputState struct {
+		box Outbox
+		// serialises the flushes, so that the messages leave in the order they were sent
+		mtx sync.Mutex
+		out chan<- Message
+		end func(result interface{})
+		// set when the party is driven by a StepParty
+		stepping bool
+	}
+
+	// StepParty drives a party as a state machine, without channels or goroutines
+	StepParty struct {
+		party   Party
+		started bool
+	}
+)
+
+// NewBaseParty returns the BaseParty of a party of the channel API under `params`, which sends the messages of its
+// rounds to `out` and passes its result to `end`, usually a send to the party's typed end channel
+func NewBaseParty(params *Parameters, out chan<- Message, end func(result interface{})) *BaseParty {
+	return &BaseParty{out: outputState{box: Outbox{params: params}, out: out, end: end}}
+}
+
+// Outbox returns the outbox that the rounds of the party send to
+func (p *BaseParty) Outbox() *Outbox {
+	return &p.out.box
+}
+
+// Send stamps an outgoing message with Parameters.StampMessage and queues it. A message that cannot be stamped is
+// dropped, and the error is returned by Err.
+func (o *Outbox) Send(msg Message) {
+	stamped, err := o.params.StampMessage(msg)
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	if err != nil {
+		if o.err == nil {
+			o.err = err
+		}
+		return
+	}
+	o.msgs = append(o.msgs, stamped)
+}
+
+// Finish queues the result of the protocol, after the messages sent before it
+func (o *Outbox) Finish(result interface{}) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	o.result, o.finished = result, true
+}
+
+// Take empties the outbox, returning the messages queued by Send and, once the protocol has finished, its result.
+// It is used by parties which drive the rounds of others, such as batch signing.
+func (o *Outbox) Take() (msgs []Message, result interface{}, finished bool) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	msgs, result, finished = o.msgs, o.result, o.finished
+	o.msgs, o.result, o.finished = nil, nil, false
+	return
+}
+
+// Err returns the first error met by Send since the last call, and clears it. Start and Update return it as an
+// error of the party.
+func (o *Outbox) Err() error {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	err := o.err
+	o.err = nil
+	return err
+}
+
+// queued returns the messages waiting in the outbox, and whether the protocol has finished
+func (o *Outbox) queued() ([]Message, bool) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	return append([]Message(nil), o.msgs...), o.finished
+}
+
+// resend queues messages that were stamped when they were first sent, such as those of a resumed round
+func (o *Outbox) resend(msgs []Message) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	o.msgs = append(o.msgs, msgs...)
+}
+
+// NewStepParty drives `party`, which must not have been started. The channels given to the constructor of the party
+// are not used and may be nil. A StepParty must not be stepped concurrently.
+func NewStepParty(party Party) (*StepParty, error) {
+	if party.Running() {
+		return nil, errors.New("the party has already been started")
+	}
+	party.output().stepping = true
+	return &StepParty{party: party}, nil
+}
+
+// Step starts the party on the first call, then gives it the `incoming` messages in order. It returns the messages
+// that the party sent in the meantime, and the result of the protocol in the step that finished it: a
+// *keygen.LocalPartySaveData, a *common.SignatureData, etc. as sent to the end channel of the channel API. On an
+// error the remaining messages are not given to the party, and the messages sent before the error are still
+// returned.
+func (s *StepParty) Step(incoming []ParsedMessage) (outgoing []Message, result interface{}, err *Error) {
+	if !s.started {
+		s.started = true
+		err = s.party.Start()
+	}
+	for _, msg := range incoming {
+		if err != nil {
+			break
+		}
+		_, err = s.party.Update(msg)
+	}
+	outgoing, result, _ = s.party.output().box.Take()
+	return outgoing, result, err
+}
+
+// Party returns the party driven by the StepParty, for WaitingFor and the like
+func (s *StepParty) Party() Party {
+	return s.party
+}
+
+// ----- //
+
+func (p *BaseParty) output() *outputState {
+	return &p.out
+}
+
+// flush passes the outbox of a party of the channel API on to its channels. It is called without the party's lock
+// held, so that the party can take other messages while the channels are full.
+func flush(p Party) {
+	o := p.output()
+	if o.stepping || (o.out == nil && o.end == nil) {
+		return
+	}
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	msgs, result, finished := o.box.Take()
+	for _, msg := range msgs {
+		o.out <- msg
+	}
+	if finished {
+		o.end(result)
+	}
+}
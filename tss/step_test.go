@@ -0,0 +1,169 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/signing"
+	"github.com/bnb-chain/tss-lib/v2/test"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+var testMsg = big.NewInt(42)
+
+// newSigners returns step parties signing testMsg with the eddsa fixtures, with `configure` applied to the
+// parameters of each
+func newSigners(t *testing.T, configure func(i int, params *tss.Parameters)) ([]*tss.StepParty, []keygen.LocalPartySaveData, tss.SortedPartyIDs) {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	steppers := make([]*tss.StepParty, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		if configure != nil {
+			configure(i, params)
+		}
+		steppers[i], err = tss.NewStepParty(signing.NewLocalParty(testMsg, params, keys[i], nil, nil))
+		assert.NoError(t, err)
+	}
+	return steppers, keys, signPIDs
+}
+
+// runSteps steps the parties in turn until no message is left in flight or one fails. `deliver`, when not nil, may
+// change or drop (by returning nil) each message on its way to a recipient.
+func runSteps(t *testing.T, steppers []*tss.StepParty, deliver func(msg tss.ParsedMessage, to *tss.PartyID) tss.ParsedMessage) ([]interface{}, *tss.Error) {
+	results := make([]interface{}, len(steppers))
+	inboxes := make([][]tss.ParsedMessage, len(steppers))
+	send := func(out tss.Message) {
+		bz, _, err := out.WireBytes()
+		assert.NoError(t, err)
+		for j, S := range steppers {
+			to := S.Party().PartyID()
+			if j == out.GetFrom().Index || (!out.IsBroadcast() && out.GetTo()[0].Index != j) {
+				continue
+			}
+			parsed, err := tss.ParseSessionWireMessage(bz, out.GetFrom(), out.IsBroadcast(), out.WireMsg().GetSessionId())
+			assert.NoError(t, err)
+			if deliver != nil {
+				if parsed = deliver(parsed, to); parsed == nil {
+					continue
+				}
+			}
+			inboxes[j] = append(inboxes[j], parsed)
+		}
+	}
+	for pending := true; pending; {
+		for i, S := range steppers {
+			incoming := inboxes[i]
+			inboxes[i] = nil
+			outgoing, result, err := S.Step(incoming)
+			if err != nil {
+				return results, err
+			}
+			for _, out := range outgoing {
+				send(out)
+			}
+			if result != nil {
+				results[i] = result
+			}
+		}
+		pending = false
+		for _, inbox := range inboxes {
+			pending = pending || 0 < len(inbox)
+		}
+	}
+	return results, nil
+}
+
+func verifySignature(t *testing.T, key keygen.LocalPartySaveData, result interface{}) {
+	sig, ok := result.(*common.SignatureData)
+	if !assert.True(t, ok, "the result must be a signature") {
+		return
+	}
+	pk := edwards.PublicKey{Curve: tss.Edwards(), X: key.EDDSAPub.X(), Y: key.EDDSAPub.Y()}
+	parsed, err := edwards.ParseSignature(sig.Signature)
+	assert.NoError(t, err)
+	assert.True(t, edwards.Verify(&pk, testMsg.Bytes(), parsed.R, parsed.S), "eddsa verify must pass")
+}
+
+func TestStepParty(t *testing.T) {
+	steppers, keys, _ := newSigners(t, nil)
+	results, err := runSteps(t, steppers, nil)
+	assert.Nil(t, err)
+	for i, result := range results {
+		verifySignature(t, keys[0], result)
+		assert.Empty(t, steppers[i].Party().WaitingFor())
+	}
+
+	// the result is returned once, by the step that finished the party
+	outgoing, result, err := steppers[0].Step(nil)
+	assert.Nil(t, err)
+	assert.Empty(t, outgoing)
+	assert.Nil(t, result)
+}
+
+func TestStepPartyStopsAtError(t *testing.T) {
+	steppers, _, signPIDs := newSigners(t, nil)
+	outgoing, _, err := steppers[0].Step(nil)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, outgoing, "the first step starts the party")
+
+	// a message from a party that is not a signer is rejected, and the messages after it are not given to the party
+	stranger := tss.GenerateTestPartyIDs(testParticipants + 1)[testParticipants]
+	bogus := signing.NewSignRound1Message(stranger, commitments.HashCommitment(big.NewInt(1)))
+	valid := signing.NewSignRound1Message(signPIDs[1], commitments.HashCommitment(big.NewInt(1)))
+	_, _, err = steppers[0].Step([]tss.ParsedMessage{bogus, valid})
+	assert.NotNil(t, err)
+	assert.Contains(t, steppers[0].Party().WaitingFor(), signPIDs[1], "the message after the error must not be stored")
+}
+
+func TestNewStepPartyRunning(t *testing.T) {
+	steppers, _, _ := newSigners(t, nil)
+	_, _, err := steppers[0].Step(nil)
+	assert.Nil(t, err)
+	_, stepErr := tss.NewStepParty(steppers[0].Party())
+	assert.Error(t, stepErr, "a party that has been started cannot be driven again")
+}
+
+func TestOutbox(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	box := tss.NewBaseParty(params, nil, nil).Outbox()
+
+	first := signing.NewSignRound1Message(pIDs[0], commitments.HashCommitment(big.NewInt(1)))
+	second := signing.NewSignRound1Message(pIDs[0], commitments.HashCommitment(big.NewInt(2)))
+	box.Send(first)
+	box.Send(second)
+	msgs, result, finished := box.Take()
+	assert.Equal(t, []tss.Message{first, second}, msgs, "the messages are taken in the order they were sent")
+	assert.Nil(t, result)
+	assert.False(t, finished)
+
+	box.Finish("done")
+	msgs, result, finished = box.Take()
+	assert.Empty(t, msgs)
+	assert.Equal(t, "done", result)
+	assert.True(t, finished)
+
+	msgs, result, finished = box.Take()
+	assert.Empty(t, msgs)
+	assert.Nil(t, result)
+	assert.False(t, finished, "Take empties the outbox")
+}
@@ -0,0 +1,488 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"google.golang.org/protobuf/proto"
+)
+
+// Transcripts
+//
+// A Transcript records the parameters of a party and every message it sends and receives, in the order the party
+// handled them, followed by the error it failed with or the fact that it finished. Messages are recorded as the
+// protocol sees them: after the secure channel has opened them and before it seals them. A transcript therefore
+// holds the point-to-point messages in the clear, including the secret shares of keygen and re-sharing, and must
+// be protected like the key data of the party, e.g. with the sealed package.
+
+const (
+	TranscriptSent     TranscriptEventKind = "sent"
+	TranscriptReceived TranscriptEventKind = "received"
+	TranscriptError    TranscriptEventKind = "error"
+	TranscriptFinished TranscriptEventKind = "finished"
+)
+
+type (
+	TranscriptEventKind string
+
+	// Transcript is filled in by a party given to Parameters.SetTranscript. Read it once the party has ended: the
+	// finished event is recorded after the party sends its result to the end channel, when the call to Update that
+	// ended it returns.
+	Transcript struct {
+		mtx    sync.Mutex
+		Header TranscriptHeader
+		Events []TranscriptEvent
+	}
+
+	TranscriptHeader struct {
+		Curve             CurveName
+		Party             TranscriptParty
+		Parties           []TranscriptParty
+		Threshold         int
+		NewParties        []TranscriptParty `json:",omitempty"`
+		NewThreshold      int               `json:",omitempty"`
+		SessionID         []byte            `json:",omitempty"`
+		NoProofMod        bool              `json:",omitempty"`
+		NoProofFac        bool              `json:",omitempty"`
+		IdentifiableAbort bool              `json:",omitempty"`
+		RoundTimeout      time.Duration     `json:",omitempty"`
+		EchoBroadcast     bool              `json:",omitempty"`
+		SecureChannel     bool              `json:",omitempty"`
+		// SeededRand is set when the party drew its randomness from a Parameters.SetRand source. The source itself
+		// is not recorded: a replay must be given it again.
+		SeededRand bool `json:",omitempty"`
+	}
+
+	TranscriptParty struct {
+		Id      string
+		Moniker string
+		Key     []byte
+	}
+
+	TranscriptEvent struct {
+		Kind TranscriptEventKind
+		Time time.Time
+		// Message is a sent or received message encoded with MarshalMessage; To holds the keys of the recipients
+		// of a point-to-point message that was sent
+		Message []byte   `json:",omitempty"`
+		To      [][]byte `json:",omitempty"`
+		// Error, Round and Culprits describe the error of an error event
+		Error    string   `json:",omitempty"`
+		Round    int      `json:",omitempty"`
+		Culprits [][]byte `json:",omitempty"`
+	}
+
+	// TranscriptMessage is a decoded message of a transcript
+	TranscriptMessage struct {
+		Sent bool
+		To   []*PartyID
+		Msg  ParsedMessage
+	}
+)
+
+// NewTranscript returns an empty transcript to give to Parameters.SetTranscript
+func NewTranscript() *Transcript {
+	return new(Transcript)
+}
+
+// UnmarshalTranscript decodes a transcript encoded by Transcript.Marshal
+func UnmarshalTranscript(bz []byte) (*Transcript, error) {
+	t := new(Transcript)
+	if err := json.Unmarshal(bz, t); err != nil {
+		return nil, err
+	}
+	if len(t.Header.Parties) == 0 {
+		return nil, errors.New("the transcript has no parties")
+	}
+	return t, nil
+}
+
+// Marshal encodes the transcript as JSON
+func (t *Transcript) Marshal() ([]byte, error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	return json.Marshal(t)
+}
+
+// PartyIDs returns the sorted parties of the transcript, and the new committee of a re-sharing
+func (t *Transcript) PartyIDs() (parties, newParties SortedPartyIDs) {
+	return transcriptPartyIDs(t.Header.Parties), transcriptPartyIDs(t.Header.NewParties)
+}
+
+// PartyID returns the party that recorded the transcript, as found in PartyIDs
+func (t *Transcript) PartyID() *PartyID {
+	parties, newParties := t.PartyIDs()
+	for _, Pj := range append(parties, newParties...) {
+		if bytes.Equal(Pj.GetKey(), t.Header.Party.Key) {
+			return Pj
+		}
+	}
+	return nil
+}
+
+// Messages decodes the messages of the transcript in the order they were recorded
+func (t *Transcript) Messages() ([]*TranscriptMessage, error) {
+	parties, newParties := t.PartyIDs()
+	all := append(parties, newParties...)
+	msgs := make([]*TranscriptMessage, 0, len(t.Events))
+	for i, e := range t.Events {
+		if e.Kind != TranscriptSent && e.Kind != TranscriptReceived {
+			continue
+		}
+		msg, err := UnmarshalMessage(e.Message, all)
+		if err != nil {
+			return nil, fmt.Errorf("transcript event %d: %w", i, err)
+		}
+		if msg == nil {
+			return nil, fmt.Errorf("transcript event %d has no message", i)
+		}
+		tm := &TranscriptMessage{Sent: e.Kind == TranscriptSent, Msg: msg}
+		for _, key := range e.To {
+			Pj := findPartyByKey(all, key)
+			if Pj == nil {
+				return nil, fmt.Errorf("transcript event %d is sent to an unknown party", i)
+			}
+			tm.To = append(tm.To, Pj)
+		}
+		msgs = append(msgs, tm)
+	}
+	return msgs, nil
+}
+
+// Outcome returns the last error recorded in the transcript, and whether the party finished
+func (t *Transcript) Outcome() (finished bool, err *TranscriptEvent) {
+	for i := range t.Events {
+		switch t.Events[i].Kind {
+		case TranscriptFinished:
+			finished = true
+		case TranscriptError:
+			err = &t.Events[i]
+		}
+	}
+	return
+}
+
+// ----- //
+
+// SetTranscript makes the party record its session in `t`, which must be new. The header of the transcript is taken
+// from the parameters as they are, so call it after the other setters.
+func (params *Parameters) SetTranscript(t *Transcript) {
+	curve, _ := GetCurveName(params.EC())
+	t.Header = TranscriptHeader{
+		Curve:             curve,
+		Party:             transcriptParty(params.PartyID()),
+		Parties:           transcriptParties(params.Parties().IDs()),
+		Threshold:         params.Threshold(),
+		SessionID:         params.SessionID(),
+		NoProofMod:        params.NoProofMod(),
+		NoProofFac:        params.NoProofFac(),
+		IdentifiableAbort: params.IdentifiableAbort(),
+		RoundTimeout:      params.RoundTimeout(),
+		EchoBroadcast:     params.EchoBroadcast(),
+		SecureChannel:     params.SecureChannel(),
+		SeededRand:        params.Rand() != rand.Reader,
+	}
+	params.transcript = t
+}
+
+// SetTranscript makes the party record its session in `t`, including the new committee
+func (rgParams *ReSharingParameters) SetTranscript(t *Transcript) {
+	rgParams.Parameters.SetTranscript(t)
+	t.Header.NewParties = transcriptParties(rgParams.NewParties().IDs())
+	t.Header.NewThreshold = rgParams.NewThreshold()
+}
+
+func (t *Transcript) record(e TranscriptEvent) {
+	if t == nil {
+		return
+	}
+	e.Time = time.Now()
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.Events = append(t.Events, e)
+}
+
+func (t *Transcript) recordMessage(kind TranscriptEventKind, msg Message) {
+	if t == nil {
+		return
+	}
+	parsed, ok := msg.(ParsedMessage)
+	if !ok || parsed.Content() == nil {
+		return
+	}
+	bz, err := MarshalMessage(parsed)
+	if err != nil {
+		t.record(TranscriptEvent{Kind: TranscriptError, Error: fmt.Sprintf("failed to record a message: %v", err)})
+		return
+	}
+	e := TranscriptEvent{Kind: kind, Message: bz}
+	if kind == TranscriptSent && !msg.IsBroadcast() {
+		e.To = partyKeys(msg.GetTo())
+	}
+	t.record(e)
+}
+
+func (t *Transcript) recordError(err *Error) {
+	if t == nil || err == nil {
+		return
+	}
+	t.record(TranscriptEvent{Kind: TranscriptError, Error: err.Error(), Round: err.Round(), Culprits: partyKeys(err.Culprits())})
+}
+
+// transcriptOf returns the transcript of a party without reading its state, which may not be locked
+func transcriptOf(p Party) *Transcript {
+	return p.FirstRound().Params().transcript
+}
+
+func transcriptParty(Pj *PartyID) TranscriptParty {
+	return TranscriptParty{Id: Pj.GetId(), Moniker: Pj.GetMoniker(), Key: Pj.GetKey()}
+}
+
+func transcriptParties(ids []*PartyID) []TranscriptParty {
+	parties := make([]TranscriptParty, len(ids))
+	for i, Pj := range ids {
+		parties[i] = transcriptParty(Pj)
+	}
+	return parties
+}
+
+func transcriptPartyIDs(parties []TranscriptParty) SortedPartyIDs {
+	if len(parties) == 0 {
+		return nil
+	}
+	ids := make(UnSortedPartyIDs, len(parties))
+	for i, Pj := range parties {
+		ids[i] = NewPartyID(Pj.Id, Pj.Moniker, new(big.Int).SetBytes(Pj.Key))
+	}
+	return SortPartyIDs(ids)
+}
+
+func findPartyByKey(parties []*PartyID, key []byte) *PartyID {
+	for _, Pj := range parties {
+		if bytes.Equal(Pj.GetKey(), key) {
+			return Pj
+		}
+	}
+	return nil
+}
+
+// ----- //
+
+// ReplayResult describes a replay of a transcript
+type ReplayResult struct {
+	// Err is the first error returned by the party, or nil
+	Err *Error
+	// Finished is set when the party finished the protocol
+	Finished bool
+	// Diverged is the index of the first message sent by the party that differs from the transcript, or -1
+	Diverged int
+}
+
+// Replay feeds the messages received in a transcript, in their recorded order, to `p`: a new party built with the
+// same parameters and key data as the party that recorded it. The secure channel must not be enabled on `p`, since
+// the transcript holds the messages already opened. The messages sent by `p` are read from `out`, the party's out
+// channel, and compared to the recorded ones; its end channel must be buffered. A party that drew its randomness
+// from crypto/rand sends different messages from the first round on, so a failure that depends on the party's own
+// secrets is only reproduced if it was given the same Parameters.SetRand source as the original. That source is not
+// part of the transcript, so Replay fails if the header says one was used and `p` has none.
+func Replay(t *Transcript, p Party, out <-chan Message) (*ReplayResult, error) {
+	params := p.FirstRound().Params()
+	if t.Header.SeededRand && params.Rand() == rand.Reader {
+		return nil, errors.New("the transcript was recorded with a Parameters.SetRand source, which must be set again to replay it")
+	}
+	if !bytes.Equal(t.Header.Party.Key, params.PartyID().GetKey()) {
+		return nil, errors.New("the transcript was recorded by another party")
+	}
+	if !bytes.Equal(t.Header.SessionID, params.SessionID()) {
+		return nil, errors.New("the transcript was recorded in another session")
+	}
+	if params.SecureChannel() {
+		return nil, errors.New("the secure channel must be disabled to replay a transcript")
+	}
+	msgs, err := t.Messages()
+	if err != nil {
+		return nil, err
+	}
+	var recorded [][]byte
+	for _, e := range t.Events {
+		if e.Kind == TranscriptSent {
+			recorded = append(recorded, e.Message)
+		}
+	}
+
+	var sent [][]byte
+	done, drained := make(chan struct{}), make(chan struct{})
+	keep := func(msg Message) {
+		parsed, ok := msg.(ParsedMessage)
+		if !ok {
+			sent = append(sent, nil)
+			return
+		}
+		bz, _ := MarshalMessage(parsed)
+		sent = append(sent, bz)
+	}
+	go func() {
+		defer close(drained)
+		for {
+			select {
+			case msg := <-out:
+				keep(msg)
+			case <-done:
+				// every send has completed by now; take the messages still buffered
+				for {
+					select {
+					case msg := <-out:
+						keep(msg)
+					default:
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	res := &ReplayResult{Diverged: -1}
+	if res.Err = p.Start(); res.Err == nil {
+		for _, msg := range msgs {
+			if msg.Sent {
+				continue
+			}
+			if _, res.Err = p.Update(msg.Msg); res.Err != nil {
+				break
+			}
+		}
+	}
+	close(done)
+	<-drained
+
+	res.Finished = res.Err == nil && !p.Running()
+	for i, bz := range sent {
+		if len(recorded) <= i || !replayedEqual(bz, recorded[i]) {
+			res.Diverged = i
+			break
+		}
+	}
+	if res.Diverged < 0 && len(sent) < len(recorded) {
+		res.Diverged = len(sent)
+	}
+	return res, nil
+}
+
+// replayedEqual compares two encoded messages, whose encoding is not canonical
+func replayedEqual(a, b []byte) bool {
+	wa, wb := new(MessageWrapper), new(MessageWrapper)
+	if proto.Unmarshal(a, wa) != nil || proto.Unmarshal(b, wb) != nil {
+		return false
+	}
+	ma, err := wa.GetMessage().UnmarshalNew()
+	if err != nil {
+		return false
+	}
+	mb, err := wb.GetMessage().UnmarshalNew()
+	if err != nil {
+		return false
+	}
+	wa.Message, wb.Message = nil, nil
+	return proto.Equal(wa, wb) && proto.Equal(ma, mb)
+}
+
+// Parameters returns new parameters for the party that recorded the transcript, e.g. to replay it. The echo
+// broadcast, secure channel and randomness are not set.
+func (t *Transcript) Parameters() (*Parameters, error) {
+	ec, ok := GetCurveByName(t.Header.Curve)
+	if !ok {
+		return nil, fmt.Errorf("the transcript has an unknown curve %q", t.Header.Curve)
+	}
+	parties, _ := t.PartyIDs()
+	party := t.PartyID()
+	if party == nil {
+		return nil, errors.New("the transcript was recorded by a party that is not one of its parties")
+	}
+	params := NewParameters(ec, NewPeerContext(parties), party, len(parties), t.Header.Threshold)
+	t.applyHeader(params)
+	return params, nil
+}
+
+// ReSharingParameters is Parameters for a transcript recorded in a re-sharing
+func (t *Transcript) ReSharingParameters() (*ReSharingParameters, error) {
+	ec, ok := GetCurveByName(t.Header.Curve)
+	if !ok {
+		return nil, fmt.Errorf("the transcript has an unknown curve %q", t.Header.Curve)
+	}
+	parties, newParties := t.PartyIDs()
+	party := t.PartyID()
+	if party == nil || len(newParties) == 0 {
+		return nil, errors.New("the transcript was not recorded in a re-sharing")
+	}
+	params := NewReSharingParameters(ec, NewPeerContext(parties), NewPeerContext(newParties), party,
+		len(parties), t.Header.Threshold, len(newParties), t.Header.NewThreshold)
+	t.applyHeader(params.Parameters)
+	return params, nil
+}
+
+func (t *Transcript) applyHeader(params *Parameters) {
+	if len(t.Header.SessionID) != 0 {
+		params.SetSessionID(t.Header.SessionID)
+	}
+	if t.Header.NoProofMod {
+		params.SetNoProofMod()
+	}
+	if t.Header.NoProofFac {
+		params.SetNoProofFac()
+	}
+	if t.Header.IdentifiableAbort {
+		params.SetIdentifiableAbort()
+	}
+	// the timeout is only recorded: without an abort channel the replayed party is not timed
+	params.roundTimeout = t.Header.RoundTimeout
+}
+
+// ----- //
+
+// TranscriptAudit collects the invalid proofs found in a transcript by the AuditTranscript of a protocol
+type TranscriptAudit struct {
+	task     string
+	victim   *PartyID
+	round    int
+	culprits []*PartyID
+	errs     error
+}
+
+func NewTranscriptAudit(task string, victim *PartyID) *TranscriptAudit {
+	return &TranscriptAudit{task: task, victim: victim}
+}
+
+// Fail records an invalid proof sent by `culprit` in `round`
+func (a *TranscriptAudit) Fail(round int, culprit *PartyID, err error) {
+	if a.round == 0 || round < a.round {
+		a.round = round
+	}
+	a.errs = multierror.Append(a.errs, fmt.Errorf("round %d, party %s: %w", round, culprit, err))
+	for _, Pj := range a.culprits {
+		if bytes.Equal(Pj.GetKey(), culprit.GetKey()) {
+			return
+		}
+	}
+	a.culprits = append(a.culprits, culprit)
+}
+
+// Err returns an error naming every culprit with the earliest round of a failure, or nil
+func (a *TranscriptAudit) Err() *Error {
+	if a.errs == nil {
+		return nil
+	}
+	return NewError(a.errs, a.task, a.round, a.victim, a.culprits...)
+}
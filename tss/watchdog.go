@@ -0,0 +1,110 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"fmt"
+	"time"
+)
+
+// watchdog aborts a party whose context is done or whose current round has timed out; see Parameters.SetContext
+type watchdog struct {
+	advanced chan struct{}
+	finished chan struct{}
+	// set with the party locked once it has finished, failed or been aborted
+	stopped bool
+}
+
+func (p *BaseParty) startWatchdog(params *Parameters) {
+	if params.abortOut == nil || p.watch != nil {
+		return
+	}
+	p.watch = &watchdog{
+		advanced: make(chan struct{}, 1),
+		finished: make(chan struct{}),
+	}
+	go p.runWatchdog(params, p.watch)
+}
+
+func (w *watchdog) roundAdvanced() {
+	if w == nil || w.stopped {
+		return
+	}
+	select {
+	case w.advanced <- struct{}{}:
+	default:
+	}
+}
+
+// stopWatchdog is called with the party locked once it has finished or failed, so that it is not aborted later on
+func (p *BaseParty) stopWatchdog() {
+	if w := p.watch; w != nil && !w.stopped {
+		w.stopped = true
+		close(w.finished)
+	}
+}
+
+func (p *BaseParty) runWatchdog(params *Parameters, w *watchdog) {
+	defer params.cancel()
+	var timeout <-chan time.Time
+	var timer *time.Timer
+	if 0 < params.roundTimeout {
+		timer = time.NewTimer(params.roundTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	for {
+		select {
+		case <-w.finished:
+			return
+		case <-w.advanced:
+			if timer != nil {
+				if !timer.Stop() {
+					<-timer.C
+				}
+				timer.Reset(params.roundTimeout)
+			}
+		case <-timeout:
+			p.abort(params, fmt.Errorf("round timed out after %s", params.roundTimeout))
+			return
+		case <-params.ctx.Done():
+			p.abort(params, params.ctx.Err())
+			return
+		}
+	}
+}
+
+func (p *BaseParty) abort(params *Parameters, cause error) {
+	// cancel the work in progress first, as a round may hold the lock while it runs
+	params.cancel()
+	p.lock()
+	if p.rnd == nil || p.watch.stopped {
+		// the party finished or failed while the watchdog fired
+		p.unlock()
+		return
+	}
+	p.stopWatchdog()
+	err := p.rnd.WrapError(cause, p.waitingFor()...)
+	p.aborted = err
+	p.unlock()
+	params.transcript.recordError(err)
+	if params.observer != nil {
+		params.observer.PartyFinished(params.partyID, err.Task(), err, time.Since(p.obs.started))
+	}
+	params.abortOut <- err
+}
+
+func (p *BaseParty) abortError() *Error {
+	return p.aborted
+}
+
+// halt is called with the party locked when it must not go on, e.g. once it could not save a checkpoint. Every
+// later update returns `err`.
+func (p *BaseParty) halt(err *Error) {
+	p.stopWatchdog()
+	p.aborted = err
+}
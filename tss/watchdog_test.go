@@ -0,0 +1,107 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/eddsa/signing"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+const testRoundTimeout = time.Second
+
+// newWatchedSigners returns step parties signing testMsg whose aborts are sent to the channel of each
+func newWatchedSigners(t *testing.T, ctx context.Context) ([]*tss.StepParty, []chan *tss.Error, tss.SortedPartyIDs) {
+	errChs := make([]chan *tss.Error, testThreshold+1)
+	steppers, _, signPIDs := newSigners(t, func(i int, params *tss.Parameters) {
+		errChs[i] = make(chan *tss.Error, 2)
+		params.SetContext(ctx, testRoundTimeout, errChs[i])
+	})
+	return steppers, errChs, signPIDs
+}
+
+// assertNoAbort fails if an abort is sent to any of `errChs` within two round timeouts
+func assertNoAbort(t *testing.T, errChs []chan *tss.Error, msgAndArgs ...interface{}) {
+	time.Sleep(2 * testRoundTimeout)
+	for _, errCh := range errChs {
+		select {
+		case err := <-errCh:
+			assert.Fail(t, "unexpected abort: "+err.Error(), msgAndArgs...)
+		default:
+		}
+	}
+}
+
+func TestWatchdogTimeout(t *testing.T) {
+	steppers, errChs, signPIDs := newWatchedSigners(t, context.Background())
+	silent := signPIDs[1]
+	_, err := runSteps(t, steppers, func(msg tss.ParsedMessage, _ *tss.PartyID) tss.ParsedMessage {
+		if msg.GetFrom() == silent {
+			return nil
+		}
+		return msg
+	})
+	assert.Nil(t, err)
+
+	select {
+	case abort := <-errChs[0]:
+		assert.Contains(t, abort.Culprits(), silent, "the party must name the parties it waited for")
+	case <-time.After(10 * testRoundTimeout):
+		assert.FailNow(t, "the round did not time out")
+	}
+	assertNoAbort(t, errChs[:1], "a party is aborted once")
+
+	_, _, err = steppers[0].Step([]tss.ParsedMessage{signing.NewSignRound1Message(silent, big.NewInt(1))})
+	assert.NotNil(t, err, "an aborted party must reject updates")
+}
+
+func TestWatchdogCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	steppers, errChs, _ := newWatchedSigners(t, ctx)
+	_, _, err := steppers[0].Step(nil)
+	assert.Nil(t, err)
+	cancel()
+
+	select {
+	case abort := <-errChs[0]:
+		assert.True(t, errors.Is(abort, context.Canceled))
+	case <-time.After(10 * testRoundTimeout):
+		assert.FailNow(t, "the party was not aborted")
+	}
+}
+
+func TestWatchdogStopsWhenFinished(t *testing.T) {
+	steppers, errChs, _ := newWatchedSigners(t, context.Background())
+	results, err := runSteps(t, steppers, nil)
+	assert.Nil(t, err)
+	for _, result := range results {
+		assert.NotNil(t, result)
+	}
+	assertNoAbort(t, errChs, "a party which finished must not be aborted")
+}
+
+func TestWatchdogStopsOnError(t *testing.T) {
+	steppers, errChs, signPIDs := newWatchedSigners(t, context.Background())
+	// party 1 commits to a value it does not open to party 0, which fails in a later round
+	_, err := runSteps(t, steppers, func(msg tss.ParsedMessage, to *tss.PartyID) tss.ParsedMessage {
+		if r1msg, ok := msg.Content().(*signing.SignRound1Message); ok && msg.GetFrom() == signPIDs[1] && to == signPIDs[0] {
+			r1msg.Commitment = new(big.Int).Add(new(big.Int).SetBytes(r1msg.Commitment), big.NewInt(1)).Bytes()
+		}
+		return msg
+	})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, signPIDs[0], err.Victim())
+	}
+	assertNoAbort(t, errChs[:1], "a party which failed must not be aborted as well")
+}
@@ -7,23 +7,47 @@
 package tss
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
-// Used externally to update a LocalParty with a valid ParsedMessage
+// Used externally to update a LocalParty with a valid ParsedMessage. The session ID sent with the message, if any,
+// is set on its MessageWrapper.
 func ParseWireMessage(wireBytes []byte, from *PartyID, isBroadcast bool) (ParsedMessage, error) {
 	wire := new(MessageWrapper)
-	wire.Message = new(anypb.Any)
 	wire.From = from.MessageWrapper_PartyID
 	wire.IsBroadcast = isBroadcast
-	if err := proto.Unmarshal(wireBytes, wire.Message); err != nil {
-		return nil, err
+	session := new(SessionWireMessage)
+	if err := proto.Unmarshal(wireBytes, session); err == nil && len(session.GetSessionId()) != 0 && session.GetMessage() != nil {
+		wire.SessionId = session.GetSessionId()
+		wire.Message = session.GetMessage()
+	} else {
+		// sent outside of a session, as the bare Any
+		wire.Message = new(anypb.Any)
+		if err := proto.Unmarshal(wireBytes, wire.Message); err != nil {
+			return nil, err
+		}
 	}
 	return parseWrappedMessage(wire, from)
 }
 
+// ParseSessionWireMessage is ParseWireMessage for bytes which the transport received in the session `sessionID`.
+// It returns an error when the session ID sent with the message is not `sessionID`.
+func ParseSessionWireMessage(wireBytes []byte, from *PartyID, isBroadcast bool, sessionID []byte) (ParsedMessage, error) {
+	msg, err := ParseWireMessage(wireBytes, from, isBroadcast)
+	if err != nil {
+		return nil, err
+	}
+	if received := msg.WireMsg().GetSessionId(); !bytes.Equal(received, sessionID) {
+		return nil, fmt.Errorf("ParseSessionWireMessage: the message was sent in another session (%x != %x)", received, sessionID)
+	}
+	return msg, nil
+}
+
 func parseWrappedMessage(wire *MessageWrapper, from *PartyID) (ParsedMessage, error) {
 	m, err := wire.Message.UnmarshalNew()
 	if err != nil {
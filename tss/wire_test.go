@@ -0,0 +1,83 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/v2/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/v2/eddsa/signing"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+)
+
+func newWireMessage(sessionID []byte) tss.ParsedMessage {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	params.SetSessionID(sessionID)
+	msg := signing.NewSignRound1Message(pIDs[0], commitments.HashCommitment(big.NewInt(1)))
+	stamped, err := params.StampMessage(msg)
+	if err != nil {
+		panic(err)
+	}
+	return stamped.(tss.ParsedMessage)
+}
+
+func TestWireSessionID(t *testing.T) {
+	sessionID := []byte("session")
+	msg := newWireMessage(sessionID)
+	bz, _, err := msg.WireBytes()
+	assert.NoError(t, err)
+
+	parsed, err := tss.ParseWireMessage(bz, msg.GetFrom(), msg.IsBroadcast())
+	assert.NoError(t, err)
+	assert.Equal(t, sessionID, parsed.WireMsg().GetSessionId(), "the session ID must be sent with the message")
+	assert.Equal(t, msg.Content().(*signing.SignRound1Message).GetCommitment(), parsed.Content().(*signing.SignRound1Message).GetCommitment())
+
+	parsed, err = tss.ParseSessionWireMessage(bz, msg.GetFrom(), msg.IsBroadcast(), sessionID)
+	assert.NoError(t, err)
+	assert.Equal(t, sessionID, parsed.WireMsg().GetSessionId())
+
+	_, err = tss.ParseSessionWireMessage(bz, msg.GetFrom(), msg.IsBroadcast(), []byte("another session"))
+	assert.Error(t, err, "the transport must not be able to move a message into another session")
+	_, err = tss.ParseSessionWireMessage(bz, msg.GetFrom(), msg.IsBroadcast(), nil)
+	assert.Error(t, err)
+}
+
+func TestWireWithoutSessionID(t *testing.T) {
+	msg := newWireMessage(nil)
+	bz, _, err := msg.WireBytes()
+	assert.NoError(t, err)
+
+	parsed, err := tss.ParseWireMessage(bz, msg.GetFrom(), msg.IsBroadcast())
+	assert.NoError(t, err)
+	assert.Empty(t, parsed.WireMsg().GetSessionId())
+	assert.Equal(t, msg.Content().(*signing.SignRound1Message).GetCommitment(), parsed.Content().(*signing.SignRound1Message).GetCommitment())
+
+	_, err = tss.ParseSessionWireMessage(bz, msg.GetFrom(), msg.IsBroadcast(), []byte("session"))
+	assert.Error(t, err, "a message sent outside of the session must be rejected")
+}
+
+func TestStepPartyWithSessionID(t *testing.T) {
+	steppers, keys, _ := newSigners(t, func(_ int, params *tss.Parameters) {
+		params.SetSessionID([]byte("session"))
+	})
+	// a transport which knows nothing of sessions parses the bytes with ParseWireMessage
+	results, err := runSteps(t, steppers, func(msg tss.ParsedMessage, _ *tss.PartyID) tss.ParsedMessage {
+		bz, _, err := msg.WireBytes()
+		assert.NoError(t, err)
+		parsed, err := tss.ParseWireMessage(bz, msg.GetFrom(), msg.IsBroadcast())
+		assert.NoError(t, err)
+		return parsed
+	})
+	assert.Nil(t, err)
+	for _, result := range results {
+		verifySignature(t, keys[0], result)
+	}
+}